@@ -0,0 +1,119 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AID is the attention identifier sent by the terminal with each inbound
+// message, it tells which key the user pressed
+type AID byte
+
+const (
+	AIDNone   AID = 0x60
+	AIDEnter  AID = 0x7d
+	AIDClear  AID = 0x6d
+	AIDPA1    AID = 0x6c
+	AIDPA2    AID = 0x6e
+	AIDPA3    AID = 0x6b
+	AIDSysReq AID = 0xf0
+	AIDPF1    AID = 0xf1
+	AIDPF2    AID = 0xf2
+	AIDPF3    AID = 0xf3
+	AIDPF4    AID = 0xf4
+	AIDPF5    AID = 0xf5
+	AIDPF6    AID = 0xf6
+	AIDPF7    AID = 0xf7
+	AIDPF8    AID = 0xf8
+	AIDPF9    AID = 0xf9
+	AIDPF10   AID = 0x7a
+	AIDPF11   AID = 0x7b
+	AIDPF12   AID = 0x7c
+	AIDPF13   AID = 0xc1
+	AIDPF14   AID = 0xc2
+	AIDPF15   AID = 0xc3
+	AIDPF16   AID = 0xc4
+	AIDPF17   AID = 0xc5
+	AIDPF18   AID = 0xc6
+	AIDPF19   AID = 0xc7
+	AIDPF20   AID = 0xc8
+	AIDPF21   AID = 0xc9
+	AIDPF22   AID = 0x4a
+	AIDPF23   AID = 0x4b
+	AIDPF24   AID = 0x4c
+)
+
+var pfKeys = [...]AID{
+	AIDPF1, AIDPF2, AIDPF3, AIDPF4, AIDPF5, AIDPF6, AIDPF7, AIDPF8,
+	AIDPF9, AIDPF10, AIDPF11, AIDPF12, AIDPF13, AIDPF14, AIDPF15, AIDPF16,
+	AIDPF17, AIDPF18, AIDPF19, AIDPF20, AIDPF21, AIDPF22, AIDPF23, AIDPF24,
+}
+
+// PF returns the AID of the PF key n, from 1 to 24
+func PF(n int) AID {
+	if n < 1 || n > len(pfKeys) {
+		return AIDNone
+	}
+	return pfKeys[n-1]
+}
+
+// ShortRead reports whether the AID is sent alone, without cursor address
+// nor field data
+func (a AID) ShortRead() bool {
+	switch a {
+	case AIDClear, AIDPA1, AIDPA2, AIDPA3:
+		return true
+	}
+	return false
+}
+
+func (a AID) String() string {
+	switch a {
+	case AIDNone:
+		return "None"
+	case AIDEnter:
+		return "Enter"
+	case AIDClear:
+		return "Clear"
+	case AIDPA1:
+		return "PA1"
+	case AIDPA2:
+		return "PA2"
+	case AIDPA3:
+		return "PA3"
+	case AIDSysReq:
+		return "SysReq"
+	}
+	for i, pf := range pfKeys {
+		if pf == a {
+			return fmt.Sprintf("PF%d", i+1)
+		}
+	}
+	return fmt.Sprintf("AID(0x%02x)", byte(a))
+}
+
+// ParseAID returns the AID of a key from its name as returned by String,
+// ignoring case
+func ParseAID(name string) (AID, bool) {
+	keys := append([]AID{AIDEnter, AIDClear, AIDPA1, AIDPA2, AIDPA3, AIDSysReq}, pfKeys[:]...)
+	for _, a := range keys {
+		if strings.EqualFold(a.String(), name) {
+			return a, true
+		}
+	}
+	return AIDNone, false
+}
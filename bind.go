@@ -0,0 +1,105 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Offsets of the fields of a BIND request unit
+const (
+	bindRU         = 0x31
+	bindOffLUType  = 14
+	bindOffRows    = 20
+	bindOffCols    = 21
+	bindOffAltRows = 22
+	bindOffAltCols = 23
+	bindOffSize    = 24
+	bindOffPLULen  = 27
+	bindOffPLUName = 28
+	bindPLUNameMax = 8
+)
+
+// ErrInvalidBind is returned for data that is not a BIND request
+var ErrInvalidBind = errors.New("tn3270: invalid BIND")
+
+// Bind is the SNA BIND sent by hosts when BIND-IMAGE is negotiated, it
+// starts a session between the application (the PLU) and the terminal
+type Bind struct {
+	LUType  byte   // LU type, 2 for terminals, 1 or 3 for printers
+	PLUName string // application the terminal is bound to, if given
+
+	// Rows and Cols are the default screen size, used by Erase/Write
+	Rows, Cols int
+	// AltRows and AltCols are the alternate screen size, used by
+	// Erase/Write Alternate. They are 0 when the alternate size is the one
+	// of the terminal model.
+	AltRows, AltCols int
+}
+
+// ParseBind decodes a BIND request unit, the PLU name is decoded with cp, or
+// CP037 if nil
+func ParseBind(data []byte, cp *CodePage) (*Bind, error) {
+	if len(data) == 0 || data[0] != bindRU {
+		return nil, ErrInvalidBind
+	}
+	b := &Bind{Rows: 24, Cols: 80, AltRows: 24, AltCols: 80}
+	if len(data) > bindOffLUType {
+		b.LUType = data[bindOffLUType] & 0x7f
+	}
+	if len(data) > bindOffSize {
+		switch size := data[bindOffSize]; size {
+		case 0x00, 0x02:
+			// 24x80 only
+		case 0x03:
+			b.AltRows, b.AltCols = 0, 0
+		case 0x7e:
+			b.Rows, b.Cols = int(data[bindOffRows]), int(data[bindOffCols])
+			b.AltRows, b.AltCols = b.Rows, b.Cols
+		case 0x7f:
+			b.Rows, b.Cols = int(data[bindOffRows]), int(data[bindOffCols])
+			b.AltRows, b.AltCols = int(data[bindOffAltRows]), int(data[bindOffAltCols])
+		default:
+			return nil, fmt.Errorf("tn3270: unsupported BIND screen size 0x%02x", size)
+		}
+		if b.Rows == 0 || b.Cols == 0 {
+			return nil, fmt.Errorf("tn3270: invalid BIND screen size %dx%d", b.Rows, b.Cols)
+		}
+	}
+	if len(data) > bindOffPLULen {
+		n := min(int(data[bindOffPLULen]), bindPLUNameMax)
+		if len(data) >= bindOffPLUName+n {
+			b.PLUName = codePageOrDefault(cp).Decode(data[bindOffPLUName : bindOffPLUName+n])
+		}
+	}
+	return b, nil
+}
+
+// modelSize returns the screen size of a terminal model, 24x80 for
+// unknown models
+func modelSize(deviceType string) (rows, cols int) {
+	if len(deviceType) >= 10 {
+		switch deviceType[9] {
+		case '3':
+			return 32, 80
+		case '4':
+			return 43, 80
+		case '5':
+			return 27, 132
+		}
+	}
+	return 24, 80
+}
@@ -0,0 +1,70 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"log"
+)
+
+// CertificateMapper maps the client certificate of a terminal, usually by
+// its subject, to the LU it is allowed to use and to its user. Printers
+// may only associate with that LU. An empty LU lets the terminal request
+// any LU. The terminal is rejected if ok is false.
+type CertificateMapper func(cert *x509.Certificate) (luname string, user string, ok bool)
+
+// Errors of the terminals rejected by Server.MapCertificate, they are sent
+// as INV-NAME rejects
+var (
+	ErrNoCertificate        = errors.New("tn3270: no client certificate")
+	ErrCertificateNotMapped = errors.New("tn3270: client certificate not allowed")
+	ErrCertificateLU        = errors.New("tn3270: LU not allowed for the client certificate")
+)
+
+// peerCertificate returns the client certificate of the terminal, nil if
+// the connection does not use TLS or the terminal sent none
+func (c *conn) peerCertificate() *x509.Certificate {
+	tc, ok := c.sw.current().(*tls.Conn)
+	if !ok {
+		return nil
+	}
+	certs := tc.ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return nil
+	}
+	return certs[0]
+}
+
+// authorize maps the client certificate of the terminal to its user and
+// returns the LU it is allowed to use, empty for any
+func (c *conn) authorize() (string, error) {
+	cert := c.peerCertificate()
+	c.session.Certificate = cert
+	if c.server.MapCertificate == nil {
+		return "", nil
+	}
+	if cert == nil {
+		return "", ErrNoCertificate
+	}
+	luname, user, ok := c.server.MapCertificate(cert)
+	if !ok {
+		log.Printf("tn3270: %s rejected, certificate of %s not mapped", c.remoteAddr, cert.Subject)
+		return "", ErrCertificateNotMapped
+	}
+	c.session.User = user
+	return luname, nil
+}
@@ -20,92 +20,543 @@ import (
 	"io"
 	"log"
 	"net"
+	"sync"
+	"time"
 )
 
 type Client struct {
-	luname string
-	parser Parser
-	screen TextTN3270Handler
-	read   chan []byte
-	write  chan []byte
-	msgin  chan string
-	msgout chan string
+	CodePage *CodePage // code page of the host, CP037 if nil
+	Recorder *Recorder // records the session if not nil
+	Trace    Logger    // logs the decoded protocol events if not nil
+
+	// DeviceType is the terminal model requested, IBM-3278-2-E if empty,
+	// or IBM-3287-1 if Associate is set
+	DeviceType string
+	// Associate is the device name of the terminal session a printer
+	// session is requested for, the LU name is then ignored
+	Associate string
+
+	// Functions are the TN3270E functions supported by the client,
+	// BIND-IMAGE, RESPONSES and SYSREQ if nil
+	Functions Functions
+
+	// NextLU is called with the LU rejected by the host, it returns the
+	// LU to request instead or false to give up. It is called while the
+	// client parses the data of the host and may not use the client.
+	NextLU func(rejected string, reason ReasonCode) (string, bool)
+
+	// StartTLS tells whether the connection is upgraded to TLS when the
+	// host offers START_TLS. TLSConfig is used for the handshake.
+	StartTLS  StartTLSPolicy
+	TLSConfig *tls.Config
+
+	// Environ are the NEW-ENVIRON variables sent to the host, such as USER.
+	// The requested LU is sent as DEVNAME unless set.
+	Environ map[string]string
+
+	// Options handle the telnet options the client does not support, they
+	// are refused otherwise. TN3270E, TERMINAL-TYPE, NEW-ENVIRON and
+	// START_TLS cannot be overridden.
+	Options map[byte]OptionHandler
+
+	// Timeout bounds the connection to the host and the negotiation of the
+	// device type, there is no limit if 0
+	Timeout time.Duration
+
+	// mu protects the screens and the state below, it is held while the
+	// parser runs
+	mu       sync.Mutex
+	negative *ResponseError // negative response to the last message sent
+	pending  []string       // screens to deliver once the parser is done
+	conn     net.Conn
+	sw       *switchConn // connection upgraded by START_TLS
+	secure   bool        // the connection uses TLS
+	upgrade  bool        // the host sent START_TLS FOLLOWS
+
+	luname     string // requested LU, generic if empty
+	negotiated string // LU assigned by DEVICE-TYPE IS
+	functions  Functions
+	bind       *Bind // BIND of the current session, nil if unbound
+	parser     Parser
+	telnet     *negotiator
+	screen     TextTN3270Handler
+	term       *VirtualScreenTN3270Handler
+	sscpScreen *VirtualScreenTN3270Handler // screen of the SSCP-LU session
+	sscp       bool                        // the SSCP-LU session is active
+	nvt        bool                        // the host sends NVT data
+	tn3270e    bool                        // the TN3270E option is enabled
+	nvtIn      *nvtBuffer
+	vt         vtFilter
+	read       chan []byte
+	write      chan []byte // see send
+	msgin      chan string
+	msgout     chan string
+	done       chan struct{} // closed when the connection is lost
+	ready      chan struct{} // closed when the device type is negotiated
+	readyErr   error         // why the negotiation failed
+}
+
+// ErrConnectionLost is returned when the connection is lost before the
+// device type is negotiated
+var ErrConnectionLost = errors.New("tn3270: connection lost during negotiation")
+
+// ErrNegotiationTimeout is returned when the device type is not negotiated
+// within the Timeout of the client
+var ErrNegotiationTimeout = errors.New("tn3270: negotiation timed out")
+
+// negotiationDone ends the negotiation, with ErrTLSRequired if the host
+// goes on in plain text while TLS is required. It reports whether the
+// session goes on, c.mu must be held.
+func (c *Client) negotiationDone(err error) bool {
+	select {
+	case <-c.ready:
+	default:
+		if err == nil && c.tlsMissing() {
+			err = ErrTLSRequired
+		}
+		c.readyErr = err
+		close(c.ready)
+	}
+	return c.readyErr == nil
 }
 
 func (c *Client) recv(conn io.Reader) {
+	defer close(c.done)
+	defer c.nvtIn.close()
+	defer func() {
+		c.mu.Lock()
+		c.negotiationDone(ErrConnectionLost)
+		c.mu.Unlock()
+	}()
 	recv_buf := make([]byte, 2048)
 	for {
-		n, _ := conn.Read(recv_buf)
-		if n == 0 {
-			break
+		n, err := conn.Read(recv_buf)
+		if n > 0 {
+			c.mu.Lock()
+			if err := c.parser.Parse(recv_buf[:n]); err != nil {
+				log.Printf("ERROR: %s", err)
+			}
+			pending := c.pending
+			c.pending = nil
+			upgrade := c.upgrade
+			c.upgrade = false
+			c.mu.Unlock()
+			for _, s := range pending {
+				c.msgin <- s
+			}
+			if upgrade && c.startTLS() != nil {
+				c.Close()
+				break
+			}
 		}
-		err := c.parser.Parse(recv_buf[:n])
 		if err != nil {
-			log.Printf("ERROR: %s", err)
+			break
 		}
 	}
 }
 
-func (c *Client) send(conn io.Writer) {
+// send writes the data queued to conn until done is closed. The queue is
+// buffered so that the parser answers the host without waiting for it to
+// read, both sides would block otherwise on synchronous connections such as
+// net.Pipe.
+func send(conn io.Writer, queue <-chan []byte, done <-chan struct{}) {
 	for {
-		data := <-c.write
-		conn.Write(data)
+		select {
+		case data := <-queue:
+			conn.Write(data)
+		case <-done:
+			return
+		}
 	}
 }
 
-func (c *Client) handle(conn net.Conn) {
+// startTLS sends START_TLS FOLLOWS and runs the TLS handshake, the options
+// are then negotiated again over TLS
+func (c *Client) startTLS() error {
+	err := c.sw.upgrade(startTLSFollowsSubneg, nil, c.TLSConfig, false)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		c.negotiationDone(err)
+		return err
+	}
+	c.secure = true
+	c.tn3270e = false
+	c.telnet.reset()
+	return nil
+}
+
+// leaveNVT ends NVT mode once an option of 3270 mode is enabled, c.mu must
+// be held
+func (c *Client) leaveNVT() {
+	c.nvt = false
+	c.parser.(*telnetParser).leaveNVT()
+}
+
+// options returns the Options of the client, the ones of 3270 mode end NVT
+// mode once enabled
+func (c *Client) options() map[byte]OptionHandler {
+	options := make(map[byte]OptionHandler, len(c.Options))
+	for opt, h := range c.Options {
+		if tn3270Options[opt] {
+			onChange := h.OnChange
+			h.OnChange = func(local bool, enabled bool) {
+				if enabled {
+					c.leaveNVT()
+				}
+				if onChange != nil {
+					onChange(local, enabled)
+				}
+			}
+		}
+		options[opt] = h
+	}
+	return options
+}
+
+// tlsMissing ends the connection if TLS is required but the connection
+// is still in plain text, c.mu must be held
+func (c *Client) tlsMissing() bool {
+	if c.StartTLS != StartTLSRequired || c.secure {
+		return false
+	}
+	c.conn.Close()
+	return true
+}
+
+// whenSecure makes h answer no subnegotiation, which may hold the variables
+// of the user, until the connection uses TLS if TLS is required
+func (c *Client) whenSecure(h OptionHandler) OptionHandler {
+	answer := h.OnSubnegotiation
+	h.OnSubnegotiation = func(data []byte) []byte {
+		if c.StartTLS == StartTLSRequired && !c.secure {
+			return nil
+		}
+		return answer(data)
+	}
+	return h
+}
+
+// handle runs the session over conn and waits for the negotiation of the
+// device type until deadline, if not zero
+func (c *Client) handle(conn net.Conn, deadline time.Time) (chan string, error) {
+	c.screen.CodePage = c.CodePage
+	c.term.CodePage = c.CodePage
+	c.sscpScreen.CodePage = c.CodePage
+	c.secure = isTLS(conn)
+	c.sw = &switchConn{conn: conn}
+	conn = c.sw
+	if c.Recorder != nil {
+		conn = c.Recorder.Conn(conn)
+	}
+	if c.Trace != nil {
+		conn = newTraceConn(conn, c.Trace, "", c.CodePage, false)
+	}
+	c.mu.Lock()
+	c.conn = conn
+	c.telnet = newNegotiator(func(data []byte) { c.write <- data }, map[byte]OptionHandler{
+		OptionTN3270E: {Local: true, OnChange: func(local bool, enabled bool) {
+			c.tn3270e = enabled
+			if enabled {
+				c.leaveNVT()
+			}
+		}},
+		OptionTerminalType: c.whenSecure(terminalTypeHandler(c.deviceType)),
+		OptionNewEnviron:   c.whenSecure(environHandler(c.environ)),
+		OptionStartTLS: {Local: c.StartTLS != StartTLSDisabled && !c.secure, OnSubnegotiation: func(data []byte) []byte {
+			if isStartTLSFollows(data) && !c.secure && c.telnet.enabled(OptionStartTLS, true) {
+				c.upgrade = true
+			}
+			return nil
+		}},
+	}, c.options())
+	c.mu.Unlock()
 	go c.recv(conn)
-	go c.send(conn)
+	go send(conn, c.write, c.done)
+	var timeout <-chan time.Time
+	if !deadline.IsZero() {
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	select {
+	case <-c.ready:
+	case <-timeout:
+		c.mu.Lock()
+		c.negotiationDone(ErrNegotiationTimeout)
+		c.mu.Unlock()
+	}
+	if c.readyErr != nil {
+		conn.Close()
+		return nil, c.readyErr
+	}
+	return c.msgin, nil
 }
 
+// deadline returns when the connection must be negotiated, zero without
+// Timeout
+func (c *Client) deadline() time.Time {
+	if c.Timeout == 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(c.Timeout)
+}
+
+// Connect opens a session to the host and returns the channel its screens
+// are delivered to once the device type is negotiated. A *NegotiationError
+// is returned if the host rejects the LU.
 func (c *Client) Connect(addr string) (chan string, error) {
+	deadline := c.deadline()
 	var conn net.Conn
 	var err error
-	conn, err = net.Dial("tcp", addr)
+	conn, err = (&net.Dialer{Deadline: deadline}).Dial("tcp", addr)
 	if err != nil {
 		return nil, err
 	}
-	if debugServerConnections {
-		conn = newLoggingConn("server", conn)
-	}
-	go c.handle(conn)
-	return c.msgin, nil
+	return c.handle(conn, deadline)
 }
 
 func (c *Client) ConnectTLS(addr string, tslconfig *tls.Config) (chan string, error) {
+	deadline := c.deadline()
 	var conn net.Conn
 	var err error
-	conn, err = tls.Dial("tcp", addr, tslconfig)
+	conn, err = tls.DialWithDialer(&net.Dialer{Deadline: deadline}, "tcp", addr, tslconfig)
 	if err != nil {
 		return nil, err
 	}
-	if debugServerConnections {
-		conn = newLoggingConn("server", conn)
+	return c.handle(conn, deadline)
+}
+
+// ConnectConn runs the session over an established connection, such as one
+// end of a net.Pipe
+func (c *Client) ConnectConn(conn net.Conn) (chan string, error) {
+	return c.handle(conn, c.deadline())
+}
+
+// Close closes the connection to the host
+func (c *Client) Close() error {
+	c.mu.Lock()
+	conn := c.conn
+	c.mu.Unlock()
+	if conn == nil {
+		return nil
 	}
-	go c.handle(conn)
-	return c.msgin, nil
+	return conn.Close()
 }
 
-func (c *Client) Send(s string) chan string {
-	c.write <- []byte{0x00, 0x00, 0x00, 0x00, 0x00}
-	c.write <- []byte{0x7d, 0xc1, 0x50, 0x11, 0xc1, 0x50}
-	c.write <- A2E([]byte(s))
-	c.write <- []byte{0xff, 0xef}
+// Done returns a channel closed when the connection to the host is lost
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Screens returns the channel the screens of the host are delivered to, the
+// one returned by Connect, for sessions connected by someone else such as a
+// Pool
+func (c *Client) Screens() chan string {
 	return c.msgin
 }
 
+// sendRecord sends a record and returns the channel the next screen is
+// delivered to
+func (c *Client) sendRecord(dataType byte, data []byte) chan string {
+	c.mu.Lock()
+	c.negative = nil
+	c.term.LockKeyboard()
+	c.mu.Unlock()
+	record := append([]byte{dataType, 0x00, 0x00, 0x00, 0x00}, escapeIAC(data)...)
+	return c.sendRaw(append(record, 0xff, 0xef))
+}
+
+func (c *Client) sendRaw(data []byte) chan string {
+	select {
+	case c.write <- data:
+	case <-c.done:
+	}
+	return c.msgin
+}
+
+// Send sends text with the Enter key. In the SSCP-LU session the text is
+// sent as is, as SSCP-LU data.
+func (c *Client) Send(s string) chan string {
+	text := codePageOrDefault(c.CodePage).Encode(s)
+	if c.SSCPActive() {
+		return c.sendRecord(dataTypeSSCPLU, text)
+	}
+	data := []byte{0x7d, 0xc1, 0x50, 0x11, 0xc1, 0x50}
+	return c.sendRecord(dataType3270, append(data, text...))
+}
+
+// SysReq presses the SYSREQ key, which switches between the SSCP-LU and the
+// LU-LU sessions when SYSREQ is negotiated. The SYSREQ AID is sent
+// otherwise.
+func (c *Client) SysReq() chan string {
+	if !c.NegotiatedFunctions().Has(FunctionSysreq) {
+		return c.sendRecord(dataType3270, []byte{byte(AIDSysReq)})
+	}
+	return c.sendRaw([]byte{0xff, 0xf5}) // AO
+}
+
+// SSCPActive reports whether the SSCP-LU session is active, that is
+// whether the last screen received is SSCP-LU data
+func (c *Client) SSCPActive() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sscp
+}
+
+// NVTActive reports whether the host is in NVT (line mode), that is
+// whether the last data received is NVT data
+func (c *Client) NVTActive() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.nvt
+}
+
+// NVT returns the stream of the NVT data exchanged with the host, before it
+// switches to 3270 mode or in NVT-DATA records. Reads return the ASCII text
+// received, without carriage returns and VT100 control sequences, and
+// io.EOF once the connection is closed. Writes send text as is, lines should
+// end with "\r\n".
+func (c *Client) NVT() io.ReadWriter {
+	return nvtStream{c}
+}
+
+type nvtStream struct {
+	c *Client
+}
+
+func (s nvtStream) Read(p []byte) (int, error) {
+	return s.c.nvtIn.Read(p)
+}
+
+func (s nvtStream) Write(p []byte) (int, error) {
+	data := escapeIAC(p)
+	s.c.mu.Lock()
+	tn3270e := s.c.tn3270e
+	s.c.mu.Unlock()
+	if tn3270e {
+		data = append(append([]byte{dataTypeNVT, 0x00, 0x00, 0x00, 0x00}, data...), 0xff, 0xef)
+	}
+	select {
+	case s.c.write <- data:
+		return len(p), nil
+	case <-s.c.done:
+		return 0, io.ErrClosedPipe
+	}
+}
+
+// OnNVTData makes the text received in NVT mode available to NVT readers
+func (c *Client) OnNVTData(data []byte) {
+	if !c.negotiationDone(nil) {
+		return
+	}
+	c.nvt = true
+	c.nvtIn.write(c.vt.filter(data))
+}
+
+// SSCPScreen returns a copy of the screen of the SSCP-LU session
+func (c *Client) SSCPScreen() *VirtualScreenTN3270Handler {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sscpScreen.snapshot()
+}
+
+// OnTN3270SSCPLUData activates the SSCP-LU session and delivers its screen.
+// The screen is cleared when the session gets active.
+func (c *Client) OnTN3270SSCPLUData(data []byte) {
+	if !c.negotiationDone(nil) {
+		return
+	}
+	if !c.sscp {
+		c.sscp = true
+		c.sscpScreen.clear()
+	}
+	c.nvt = false
+	c.sscpScreen.writeSSCP(data)
+	c.pending = append(c.pending, c.sscpScreen.String())
+}
+
+// SendAID sends an AID with the modified fields of the screen, as if the
+// operator had pressed the matching key
+func (c *Client) SendAID(aid AID) chan string {
+	c.mu.Lock()
+	data := c.term.ReadModified(aid)
+	c.mu.Unlock()
+	return c.sendRecord(dataType3270, data)
+}
+
+// Screen returns a copy of the current screen
+func (c *Client) Screen() *VirtualScreenTN3270Handler {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.term.snapshot()
+}
+
+// Type types text at the cursor, see VirtualScreenTN3270Handler.Type
+func (c *Client) Type(s string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.term.Type(s)
+}
+
+// EraseEOF erases the field holding the cursor from the cursor to its end
+func (c *Client) EraseEOF() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.term.EraseEOF()
+}
+
+// Tab moves the cursor to the next unprotected field
+func (c *Client) Tab() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.term.Tab()
+}
+
+// MoveCursor moves the cursor, rows and columns start at 0
+func (c *Client) MoveCursor(row, col int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.term.MoveCursor(row*c.term.Cols() + col)
+}
+
 func (c *Client) SendRecv(s string) string {
 	return <-c.Send(s)
 }
 
+// ResponseError returns the negative response of the host to the last
+// message sent, nil if there was none
+func (c *Client) ResponseError() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.negative == nil {
+		return nil
+	}
+	return c.negative
+}
+
+// OnTN3270Response delivers the unchanged screen on negative responses so
+// that callers waiting for the next screen are not blocked, and resets the
+// keyboard so that the input can be corrected
+func (c *Client) OnTN3270Response(seq uint16, err *ResponseError) {
+	if err == nil {
+		return
+	}
+	c.negative = err
+	c.term.locked = false
+	c.pending = append(c.pending, c.screen.String())
+}
+
 func (c *Client) OnTNCommand(b byte) {
 
 }
 
 func (c *Client) OnTNArgCommand(b byte, arg byte) {
-	if b == 0xfd && arg == 0x28 {
-		c.write <- []byte{0xff, 0xfb, 0x28}
-	}
+	c.telnet.receive(b, arg)
+}
+
+func (c *Client) OnTNSubnegotiation(option byte, data []byte) bool {
+	return c.telnet.subnegotiation(option, data)
 }
 
 func (c *Client) OnError([]byte, int) error {
@@ -117,38 +568,160 @@ func (c *Client) OnTN3270DeviceTypeRequest([]byte, []byte, []byte) {
 }
 
 func (c *Client) OnTN3270DeviceTypeIs(model []byte, name []byte) {
-	c.write <- []byte("\xff\xfa\x28\x03\x07\x00\x02\x04\xff\xf0")
+	c.negotiated = string(name)
+	if !c.negotiationDone(nil) {
+		return
+	}
+	c.write <- functionsRequest(c.supportedFunctions())
 }
 
-func (c *Client) OnTN3270DeviceTypeReject(byte) {
+// OnTN3270DeviceTypeReject requests the next LU if NextLU gives one, and
+// fails the connection otherwise
+func (c *Client) OnTN3270DeviceTypeReject(reason byte) {
+	if c.NextLU != nil && c.Associate == "" {
+		if lu, ok := c.NextLU(c.luname, ReasonCode(reason)); ok {
+			c.luname = lu
+			c.OnTN3270SendDeviceType()
+			return
+		}
+	}
+	lu := c.luname
+	if c.Associate != "" {
+		lu = c.Associate
+	}
+	c.negotiationDone(&NegotiationError{LU: lu, Reason: ReasonCode(reason)})
 }
 
-func (c *Client) OnTN3270FunctionsIs([]byte) {
+func (c *Client) OnTN3270FunctionsIs(functions []byte) {
+	c.functions = parseFunctions(functions)
 }
 
+// OnTN3270FunctionsRequest agrees to the functions requested by the host if
+// they are all supported, and requests the supported ones otherwise
 func (c *Client) OnTN3270FunctionsRequest(functions []byte) {
-	c.write <- []byte("\xff\xfa\x28\x03\x04")
-	c.write <- functions
-	c.write <- []byte("\xff\xf0")
+	agreed, answer := answerFunctions(parseFunctions(functions), c.supportedFunctions())
+	if agreed != nil {
+		c.functions = agreed
+	}
+	c.write <- answer
+}
+
+func (c *Client) supportedFunctions() Functions {
+	if c.Functions == nil {
+		return Functions{FunctionBindImage, FunctionResponses, FunctionSysreq}
+	}
+	return c.Functions
+}
+
+// NegotiatedFunctions returns the TN3270E functions agreed with the host
+func (c *Client) NegotiatedFunctions() Functions {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.functions
+}
+
+// deviceType returns the device type requested to the host
+func (c *Client) deviceType() string {
+	switch {
+	case c.DeviceType != "":
+		return c.DeviceType
+	case c.Associate != "":
+		return "IBM-3287-1"
+	}
+	return "IBM-3278-2-E"
+}
+
+// environ returns the NEW-ENVIRON variables of the client
+func (c *Client) environ() map[string]string {
+	env := make(map[string]string, len(c.Environ)+1)
+	if c.luname != "" {
+		env["DEVNAME"] = c.luname
+	}
+	for name, value := range c.Environ {
+		env[name] = value
+	}
+	return env
 }
 
 func (c *Client) OnTN3270SendDeviceType() {
-	c.write <- []byte{0xff, 0xfa, 0x28, 0x02, 0x07}
-	c.write <- []byte("IBM-3278-2-E")
-	c.write <- []byte{0x01}
-	c.write <- []byte(c.luname)
-	c.write <- []byte{0xff, 0xf0}
+	if c.tlsMissing() {
+		c.negotiationDone(ErrTLSRequired)
+		return
+	}
+	connect := c.luname
+	if c.Associate != "" {
+		connect = ""
+	}
+	c.write <- deviceTypeRequest(c.deviceType(), c.Associate, connect)
+}
+
+// OnTN3270Bind sizes the screen as requested by the BIND, the alternate
+// size defaults to the one of the terminal model
+func (c *Client) OnTN3270Bind(data []byte) {
+	bind, err := ParseBind(data, c.CodePage)
+	if err != nil {
+		log.Printf("tn3270: %v", err)
+		return
+	}
+	c.bind = bind
+	altRows, altCols := bind.AltRows, bind.AltCols
+	if altRows == 0 || altCols == 0 {
+		altRows, altCols = modelSize(c.deviceType())
+	}
+	c.term.setSizes(bind.Rows, bind.Cols, altRows, altCols)
 }
 
+// OnTN3270Unbind ends the session with the application, the screen is
+// cleared and gets back the size of the terminal model
+func (c *Client) OnTN3270Unbind([]byte) {
+	c.bind = nil
+	c.negative = nil
+	rows, cols := modelSize(c.deviceType())
+	c.term.setSizes(24, 80, rows, cols)
+	c.screen.OnTN3270Command(0xf5)
+}
+
+// Bind returns the BIND of the session with the host application, nil
+// until BIND-IMAGE is negotiated and a BIND is received, or after an
+// UNBIND
+func (c *Client) Bind() *Bind {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bind
+}
+
+// LUName returns the LU assigned by the host, empty until the device type
+// is negotiated
+func (c *Client) LUName() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.negotiated
+}
+
+// NewClient returns a client requesting the given LU, or a generic LU if
+// luname is empty
 func NewClient(luname string) (c *Client) {
 	c = new(Client)
 	c.luname = luname
-	c.parser = NewParser(c, c, &c.screen, c)
+	c.term = NewVirtualScreenTN3270Handler(24, 80)
+	c.sscpScreen = NewVirtualScreenTN3270Handler(24, 80)
+	c.nvtIn = newNVTBuffer()
+	c.parser = NewParser(c, c, NewMultiHandler(&c.screen, c.term), c)
 	c.screen.rows = 24
-	c.screen.HandleMessage = func(s string) { c.msgin <- s }
+	c.screen.HandleMessage = func(s string) {
+		// Hosts that do not negotiate TN3270E send screens right away
+		if !c.negotiationDone(nil) {
+			return
+		}
+		c.sscp = false
+		c.nvt = false
+		c.pending = append(c.pending, s)
+	}
 	c.read = make(chan []byte)
-	c.write = make(chan []byte)
+	c.write = make(chan []byte, 16)
 	c.msgin = make(chan string)
 	c.msgout = make(chan string)
+	c.done = make(chan struct{})
+	c.ready = make(chan struct{})
 	return
 }
@@ -1,13 +1,17 @@
 package tn3270_test
 
 import (
+	"bufio"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"net"
 	"strings"
+	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -154,4 +158,751 @@ var _ = Describe("TN3270 Client", func() {
 			Expect(output).To(Equal("ECHO: Hello"))
 		})
 	})
+
+	Describe("LU negotiation", func() {
+		BeforeEach(func() {
+			pool := tn3270.NewLUPool()
+			Expect(pool.AddRange("TERM01", "TERM02")).To(Succeed())
+			pool.AddPrinter("TERM01", "PRT01")
+			server = &tn3270.Server{Handler: &MyHandler{}, LUPool: pool}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		connect := func(client *tn3270.Client) {
+			conn, host := net.Pipe()
+			go server.ServeConn(host)
+			recv, err := client.ConnectConn(conn)
+			Expect(err).To(Succeed())
+			<-recv
+		}
+
+		It("Should request a generic LU without name", func() {
+			client := tn3270.NewClient("")
+			Expect(client.LUName()).To(BeEmpty())
+			connect(client)
+			Expect(client.LUName()).To(Equal("TERM01"))
+		})
+
+		It("Should request a specific LU", func() {
+			client := tn3270.NewClient("TERM02")
+			connect(client)
+			Expect(client.LUName()).To(Equal("TERM02"))
+		})
+
+		It("Should associate a printer session to a terminal", func() {
+			terminal := tn3270.NewClient("")
+			connect(terminal)
+			printer := tn3270.NewClient("")
+			printer.Associate = terminal.LUName()
+			// printers get no welcome screen
+			conn, host := net.Pipe()
+			go server.ServeConn(host)
+			_, err := printer.ConnectConn(conn)
+			Expect(err).To(Succeed())
+			Expect(printer.LUName()).To(Equal("PRT01"))
+		})
+
+		It("Should fail with the reason of the host", func() {
+			client := tn3270.NewClient("OTHER")
+			conn, host := net.Pipe()
+			go server.ServeConn(host)
+			_, err := client.ConnectConn(conn)
+			Expect(err).To(Equal(&tn3270.NegotiationError{LU: "OTHER", Reason: tn3270.ReasonInvName}))
+			Eventually(client.Done()).Should(BeClosed())
+		})
+
+		It("Should give up when the host does not negotiate in time", func() {
+			client := tn3270.NewClient("")
+			client.Timeout = 50 * time.Millisecond
+			conn, host := net.Pipe()
+			defer host.Close()
+			go io.Copy(ioutil.Discard, host)
+			_, err := client.ConnectConn(conn)
+			Expect(err).To(Equal(tn3270.ErrNegotiationTimeout))
+			Eventually(client.Done()).Should(BeClosed())
+		})
+
+		It("Should retry with the next LU", func() {
+			connect(tn3270.NewClient("TERM01"))
+			var rejected []string
+			client := tn3270.NewClient("TERM01")
+			client.NextLU = func(lu string, reason tn3270.ReasonCode) (string, bool) {
+				Expect(reason).To(Equal(tn3270.ReasonDeviceInUse))
+				rejected = append(rejected, lu)
+				return "TERM02", lu != "TERM02"
+			}
+			connect(client)
+			Expect(client.LUName()).To(Equal("TERM02"))
+			Expect(rejected).To(Equal([]string{"TERM01"}))
+
+			client = tn3270.NewClient("TERM01")
+			client.NextLU = func(lu string, reason tn3270.ReasonCode) (string, bool) {
+				return "TERM02", lu != "TERM02"
+			}
+			conn, host := net.Pipe()
+			go server.ServeConn(host)
+			_, err := client.ConnectConn(conn)
+			Expect(err).To(Equal(&tn3270.NegotiationError{LU: "TERM02", Reason: tn3270.ReasonDeviceInUse}))
+		})
+	})
+
+	Describe("FUNCTIONS negotiation", func() {
+		var functions chan tn3270.Functions
+
+		BeforeEach(func() {
+			functions = make(chan tn3270.Functions, 1)
+			server = &tn3270.Server{Handler: tn3270.HandlerFunc(func(w tn3270.ResponseWriter, r *tn3270.Request) {
+				functions <- r.Functions
+			})}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		negotiate := func(client *tn3270.Client) (tn3270.Functions, tn3270.Functions) {
+			conn, host := net.Pipe()
+			go server.ServeConn(host)
+			recv, err := client.ConnectConn(conn)
+			Expect(err).To(Succeed())
+			<-recv
+			client.Send("")
+			return client.NegotiatedFunctions(), <-functions
+		}
+
+		It("Should agree on the functions supported by both sides", func() {
+			client, host := negotiate(tn3270.NewClient(""))
+			Expect(client).To(Equal(tn3270.Functions{tn3270.FunctionResponses}))
+			Expect(host).To(Equal(client))
+		})
+
+		It("Should accept the functions requested by the client when supported", func() {
+			server.Functions = tn3270.Functions{
+				tn3270.FunctionBindImage, tn3270.FunctionDataStreamCtl, tn3270.FunctionResponses,
+				tn3270.FunctionSCSCtlCodes, tn3270.FunctionSysreq,
+			}
+			c := tn3270.NewClient("")
+			c.Functions = tn3270.Functions{tn3270.FunctionSysreq, tn3270.FunctionResponses}
+			client, host := negotiate(c)
+			Expect(client).To(Equal(tn3270.Functions{tn3270.FunctionSysreq, tn3270.FunctionResponses}))
+			Expect(host).To(Equal(client))
+			Expect(host.Has(tn3270.FunctionSysreq)).To(BeTrue())
+			Expect(host.Has(tn3270.FunctionBindImage)).To(BeFalse())
+		})
+
+		It("Should agree on no function without common ones", func() {
+			server.Functions = tn3270.Functions{tn3270.FunctionSCSCtlCodes}
+			client, host := negotiate(tn3270.NewClient(""))
+			Expect(client).To(BeEmpty())
+			Expect(host).To(BeEmpty())
+		})
+	})
+
+	Describe("BIND-IMAGE", func() {
+		bind := func(size byte, dims ...byte) []byte {
+			b := make([]byte, 28)
+			b[0] = 0x31
+			b[14] = 0x02
+			copy(b[20:24], dims)
+			b[24] = size
+			b[27] = 4
+			return append(b, tn3270.CP037.Encode("CICS")...)
+		}
+
+		It("Should decode the BIND", func() {
+			b, err := tn3270.ParseBind(bind(0x7f, 24, 80, 27, 132), nil)
+			Expect(err).To(Succeed())
+			Expect(b).To(Equal(&tn3270.Bind{LUType: 2, PLUName: "CICS", Rows: 24, Cols: 80, AltRows: 27, AltCols: 132}))
+
+			b, err = tn3270.ParseBind(bind(0x03), nil)
+			Expect(err).To(Succeed())
+			Expect(b.Rows).To(Equal(24))
+			Expect(b.AltRows).To(Equal(0))
+
+			_, err = tn3270.ParseBind([]byte{0x32, 0x01}, nil)
+			Expect(err).To(Equal(tn3270.ErrInvalidBind))
+			_, err = tn3270.ParseBind(bind(0x7e, 0, 80), nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("Should size the screen from the BIND and reset it on UNBIND", func() {
+			client := tn3270.NewClient("TERM01")
+			client.DeviceType = "IBM-3278-4-E"
+			conn, host := net.Pipe()
+			defer host.Close()
+			go io.Copy(ioutil.Discard, host)
+			go func() {
+				host.Write([]byte{0xff, 0xfd, 0x28})
+				host.Write([]byte{0xff, 0xfa, 0x28, 0x08, 0x02, 0xff, 0xf0})
+				host.Write(append(append([]byte{0xff, 0xfa, 0x28, 0x02, 0x04}, "IBM-3278-4-E\x01TERM01"...), 0xff, 0xf0))
+			}()
+			recv, err := client.ConnectConn(conn)
+			Expect(err).To(Succeed())
+			Expect(client.Bind()).To(BeNil())
+			host.Write([]byte{0xff, 0xfa, 0x28, 0x03, 0x04, 0x00, 0x02, 0xff, 0xf0})
+			host.Write(append(append([]byte{0x03, 0x00, 0x00, 0x00, 0x00}, bind(0x7f, 32, 80, 27, 132)...), 0xff, 0xef))
+			Eventually(client.Bind).Should(Equal(&tn3270.Bind{LUType: 2, PLUName: "CICS", Rows: 32, Cols: 80, AltRows: 27, AltCols: 132}))
+			Expect(client.Screen().Rows()).To(Equal(32))
+
+			// Erase/Write Alternate
+			host.Write(append(append([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x7e, 0xc3}, tn3270.CP037.Encode("WIDE")...), 0xff, 0xef))
+			Expect(<-recv).To(Equal("WIDE"))
+			Expect(client.Screen().Rows()).To(Equal(27))
+			Expect(client.Screen().Cols()).To(Equal(132))
+
+			host.Write([]byte{0x04, 0x00, 0x00, 0x00, 0x00, 0x32, 0x01, 0xff, 0xef})
+			Eventually(client.Bind).Should(BeNil())
+			Expect(client.Screen().Rows()).To(Equal(24))
+			Expect(client.Screen().String()).To(BeEmpty())
+
+			// The alternate size is the one of the model without BIND
+			host.Write(append(append([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x7e, 0xc3}, tn3270.CP037.Encode("MODEL 4")...), 0xff, 0xef))
+			Expect(<-recv).To(Equal("MODEL 4"))
+			Expect(client.Screen().Rows()).To(Equal(43))
+		})
+	})
+
+	Describe("SSCP-LU session", func() {
+		var client *tn3270.Client
+		var peer net.Conn // the server end of the connection, to inject records
+
+		BeforeEach(func() {
+			server = &tn3270.Server{
+				USSMessage:   "WELCOME TO THE HOST\nENTER LOGON APPLID(NAME)",
+				Applications: []string{"CICS"},
+				Functions:    tn3270.Functions{tn3270.FunctionResponses, tn3270.FunctionSysreq},
+				Handler: tn3270.HandlerFunc(func(w tn3270.ResponseWriter, r *tn3270.Request) {
+					io.WriteString(w, r.Session.Application+": "+r.Text)
+				}),
+			}
+			client = tn3270.NewClient("")
+			conn, mid := net.Pipe()
+			host, end := net.Pipe()
+			peer = end
+			go server.ServeConn(host)
+			go io.Copy(mid, peer)
+			go io.Copy(peer, mid)
+			recv, err := client.ConnectConn(conn)
+			Expect(err).To(Succeed())
+			Expect(<-recv).To(Equal("WELCOME TO THE HOST\nENTER LOGON APPLID(NAME)"))
+			Expect(client.SSCPActive()).To(BeTrue())
+		})
+
+		AfterEach(func() {
+			client.Close()
+			server.Close()
+		})
+
+		It("Should log on to an application", func() {
+			Expect(client.SendRecv("HELLO")).To(HaveSuffix("\nINVALID COMMAND SYNTAX"))
+			Expect(client.SendRecv("LOGON APPLID(IMS)")).To(HaveSuffix("\nUNKNOWN APPLICATION IMS"))
+			Expect(client.SSCPScreen().String()).To(HavePrefix("WELCOME TO THE HOST\n"))
+
+			Expect(client.SendRecv("logon applid(cics)")).To(Equal(""))
+			Expect(client.SSCPActive()).To(BeFalse())
+			Expect(client.SendRecv("PING")).To(Equal("CICS: PING"))
+		})
+
+		It("Should switch sessions with SYSREQ", func() {
+			client.SendRecv("LOGON APPLID(CICS)")
+			Expect(<-client.SysReq()).To(Equal("WELCOME TO THE HOST\nENTER LOGON APPLID(NAME)"))
+			Expect(client.SSCPActive()).To(BeTrue())
+			Expect(<-client.SysReq()).To(Equal(""))
+			Expect(client.SSCPActive()).To(BeFalse())
+			Expect(client.SendRecv("PING")).To(Equal("CICS: PING"))
+
+			<-client.SysReq()
+			Expect(client.SendRecv("LOGOFF")).To(HaveSuffix("\nWELCOME TO THE HOST\nENTER LOGON APPLID(NAME)"))
+			<-client.SysReq()
+			Expect(client.SSCPActive()).To(BeTrue())
+		})
+
+		It("Should ignore the data of the inactive session", func() {
+			// 3270-DATA with ENTER and PING while the SSCP-LU session is active
+			peer.Write(append(append([]byte{0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x40, 0x40, 0x11, 0x40, 0x40},
+				tn3270.CP037.Encode("PING")...), 0xff, 0xef))
+			Expect(client.SendRecv("HELLO")).To(HaveSuffix("\nINVALID COMMAND SYNTAX"))
+
+			client.SendRecv("LOGON APPLID(CICS)")
+			Expect(client.SSCPActive()).To(BeFalse())
+			// SSCP-LU-DATA with LOGOFF while the LU-LU session is active
+			peer.Write(append(append([]byte{0x07, 0x00, 0x00, 0x00, 0x02},
+				tn3270.CP037.Encode("LOGOFF")...), 0xff, 0xef))
+			Expect(client.SendRecv("PING")).To(Equal("CICS: PING"))
+			Expect(client.SSCPActive()).To(BeFalse())
+		})
+	})
+
+	Describe("NVT mode", func() {
+		It("Should read and write lines until the host switches to 3270 mode", func() {
+			client := tn3270.NewClient("TERM01")
+			conn, host := net.Pipe()
+			defer host.Close()
+			sent := make(chan []byte, 16)
+			go func() {
+				buf := make([]byte, 1024)
+				for {
+					n, err := host.Read(buf)
+					if err != nil {
+						return
+					}
+					sent <- append([]byte(nil), buf[:n]...)
+				}
+			}()
+			go host.Write([]byte("Welcome\r\n\x1b[1mlogin: \x1b[0m"))
+			recv, err := client.ConnectConn(conn)
+			Expect(err).To(Succeed())
+
+			nvt := bufio.NewReader(client.NVT())
+			line, err := nvt.ReadString('\n')
+			Expect(err).To(Succeed())
+			Expect(line).To(Equal("Welcome\n"))
+			prompt := make([]byte, 7)
+			_, err = io.ReadFull(nvt, prompt)
+			Expect(err).To(Succeed())
+			Expect(string(prompt)).To(Equal("login: "))
+			Expect(client.NVTActive()).To(BeTrue())
+
+			io.WriteString(client.NVT(), "tn3270\r\n")
+			Expect(<-sent).To(Equal([]byte("tn3270\r\n")))
+
+			host.Write([]byte{0xff, 0xfd, 0x28})
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfb, 0x28}))
+			Expect(client.NVTActive()).To(BeFalse())
+			host.Write([]byte{0xff, 0xfa, 0x28, 0x08, 0x02, 0xff, 0xf0})
+			<-sent
+			host.Write(append(append([]byte{0xff, 0xfa, 0x28, 0x02, 0x04}, "IBM-3278-2-E\x01TERM01"...), 0xff, 0xf0))
+			<-sent
+			host.Write(append(append([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0xf5, 0xc3}, tn3270.CP037.Encode("READY")...), 0xff, 0xef))
+			Expect(<-recv).To(Equal("READY"))
+
+			// NVT-DATA records of TN3270E
+			host.Write(append([]byte{0x05, 0x00, 0x00, 0x00, 0x00}, "HELLO\r\n\xff\xef"...))
+			line, err = nvt.ReadString('\n')
+			Expect(err).To(Succeed())
+			Expect(line).To(Equal("HELLO\n"))
+			Expect(client.NVTActive()).To(BeTrue())
+			io.WriteString(client.NVT(), "BYE\r\n")
+			Expect(<-sent).To(Equal(append([]byte{0x05, 0x00, 0x00, 0x00, 0x00}, "BYE\r\n\xff\xef"...)))
+
+			host.Close()
+			_, err = nvt.ReadString('\n')
+			Expect(err).To(Equal(io.EOF))
+		})
+
+		It("Should stay in NVT mode when it refuses the options of 3270 mode", func() {
+			client := tn3270.NewClient("")
+			conn, host := net.Pipe()
+			defer host.Close()
+			sent := make(chan []byte, 16)
+			go func() {
+				buf := make([]byte, 1024)
+				for {
+					n, err := host.Read(buf)
+					if err != nil {
+						return
+					}
+					sent <- append([]byte(nil), buf[:n]...)
+				}
+			}()
+			go host.Write([]byte("login: "))
+			_, err := client.ConnectConn(conn)
+			Expect(err).To(Succeed())
+
+			host.Write([]byte{0xff, 0xfd, 0x00})
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfc, 0x00}))
+			host.Write([]byte("password: "))
+			nvt := client.NVT()
+			prompt := make([]byte, 17)
+			_, err = io.ReadFull(nvt, prompt)
+			Expect(err).To(Succeed())
+			Expect(string(prompt)).To(Equal("login: password: "))
+			Expect(client.NVTActive()).To(BeTrue())
+
+			io.WriteString(nvt, "secret\r\n")
+			Expect(<-sent).To(Equal([]byte("secret\r\n")))
+		})
+	})
+
+	Describe("Telnet options", func() {
+		var host net.Conn
+		var sent chan []byte
+
+		connect := func(client *tn3270.Client) {
+			conn, peer := net.Pipe()
+			out := make(chan []byte, 16)
+			host, sent = peer, out
+			go func() {
+				buf := make([]byte, 1024)
+				for {
+					n, err := peer.Read(buf)
+					if err != nil {
+						return
+					}
+					out <- append([]byte(nil), buf[:n]...)
+				}
+			}()
+			// Some NVT data ends the negotiation of the client
+			go host.Write([]byte("login: "))
+			_, err := client.ConnectConn(conn)
+			Expect(err).To(Succeed())
+		}
+
+		AfterEach(func() {
+			if host != nil {
+				host.Close()
+				host = nil
+			}
+		})
+
+		It("Should refuse unsupported options and acknowledge requests once", func() {
+			connect(tn3270.NewClient(""))
+			host.Write([]byte{0xff, 0xfd, 0x01}) // DO ECHO
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfc, 0x01}))
+			host.Write([]byte{0xff, 0xfb, 0x03}) // WILL SUPPRESS-GO-AHEAD
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfe, 0x03}))
+			host.Write([]byte{0xff, 0xfd, 0x06}) // DO TIMING-MARK
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfb, 0x06}))
+
+			host.Write([]byte{0xff, 0xfd, 0x28})
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfb, 0x28}))
+			host.Write([]byte{0xff, 0xfd, 0x28})
+			host.Write([]byte{0xff, 0xfd, 0x06})
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfb, 0x06}))
+			host.Write([]byte{0xff, 0xfe, 0x28}) // DONT TN3270E
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfc, 0x28}))
+		})
+
+		It("Should hand registered options to their handlers", func() {
+			type change struct{ local, enabled bool }
+			changes := make(chan change, 4)
+			client := tn3270.NewClient("")
+			client.Options = map[byte]tn3270.OptionHandler{
+				tn3270.OptionSuppressGA: {Local: true, Remote: true, OnChange: func(local bool, enabled bool) {
+					changes <- change{local, enabled}
+				}},
+				0x99: {Remote: true, OnSubnegotiation: func(data []byte) []byte {
+					return append([]byte("ANSWER "), data...)
+				}},
+			}
+			connect(client)
+			host.Write([]byte{0xff, 0xfd, 0x03})
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfb, 0x03}))
+			Expect(<-changes).To(Equal(change{true, true}))
+			host.Write([]byte{0xff, 0xfb, 0x03})
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfd, 0x03}))
+			Expect(<-changes).To(Equal(change{false, true}))
+			host.Write([]byte{0xff, 0xfe, 0x03})
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfc, 0x03}))
+			Expect(<-changes).To(Equal(change{true, false}))
+
+			host.Write([]byte{0xff, 0xfd, 0x99})
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfc, 0x99}))
+			host.Write([]byte{0xff, 0xfa, 0x99, 'Q', 0xff, 0xff, 0xff, 0xf0})
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfa, 0x99, 'A', 'N', 'S', 'W', 'E', 'R', ' ', 'Q', 0xff, 0xff, 0xff, 0xf0}))
+		})
+
+		It("Should send the terminal type and the environment variables", func() {
+			client := tn3270.NewClient("TERM01")
+			client.Environ = map[string]string{"USER": "ALICE"}
+			connect(client)
+			host.Write([]byte{0xff, 0xfd, 0x18}) // DO TERMINAL-TYPE
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfb, 0x18}))
+			host.Write([]byte{0xff, 0xfa, 0x18, 0x01, 0xff, 0xf0})
+			Expect(<-sent).To(Equal(append(append([]byte{0xff, 0xfa, 0x18, 0x00}, "IBM-3278-2-E"...), 0xff, 0xf0)))
+
+			host.Write([]byte{0xff, 0xfd, 0x27}) // DO NEW-ENVIRON
+			Expect(<-sent).To(Equal([]byte{0xff, 0xfb, 0x27}))
+			host.Write([]byte("\xff\xfa\x27\x01\x00USER\x03DEVNAME\x03IBMRSEED\x01\x02\x01\x02\xff\xff\xff\xf0"))
+			Expect(string(<-sent)).To(Equal("\xff\xfa\x27\x00\x00USER\x01ALICE\x03DEVNAME\x01TERM01\x03IBMRSEED\xff\xf0"))
+			host.Write([]byte{0xff, 0xfa, 0x27, 0x01, 0xff, 0xf0})
+			Expect(string(<-sent)).To(Equal("\xff\xfa\x27\x00\x03DEVNAME\x01TERM01\x00USER\x01ALICE\xff\xf0"))
+			host.Write([]byte{0xff, 0xfa, 0x27, 0x01, 0x03, 0xff, 0xf0}) // SEND USERVAR
+			Expect(string(<-sent)).To(Equal("\xff\xfa\x27\x00\x03DEVNAME\x01TERM01\xff\xf0"))
+		})
+
+		It("Should keep the terminal type and the environment variables in the session", func() {
+			sessions := make(chan tn3270.Session, 1)
+			server = &tn3270.Server{Environ: []string{}, Handler: tn3270.HandlerFunc(func(w tn3270.ResponseWriter, r *tn3270.Request) {
+				sessions <- *r.Session
+			})}
+			defer server.Close()
+			client := tn3270.NewClient("")
+			client.Environ = map[string]string{"USER": "ALICE", "IBMAPPLID": "CICS"}
+			conn, peer := net.Pipe()
+			go server.ServeConn(peer)
+			recv, err := client.ConnectConn(conn)
+			Expect(err).To(Succeed())
+			<-recv
+			client.Send("")
+			session := <-sessions
+			Expect(session.TerminalType).To(Equal("IBM-3278-2-E"))
+			Expect(session.Environ).To(Equal(map[string]string{"USER": "ALICE", "IBMAPPLID": "CICS"}))
+		})
+
+		It("Should let terminals request their LU in the environment variables", func() {
+			server = &tn3270.Server{Environ: []string{"DEVNAME"}, Handler: &MyHandler{}}
+			defer server.Close()
+			conn, peer := net.Pipe()
+			defer conn.Close()
+			go server.ServeConn(peer)
+			buf := make([]byte, 64)
+			exchange := func(data string) string {
+				go conn.Write([]byte(data))
+				n, _ := conn.Read(buf)
+				return string(buf[:n])
+			}
+			n, _ := conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x27}))
+			n, _ = conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x18}))
+			n, _ = conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x28}))
+			Expect(exchange("\xff\xfb\x27")).To(Equal("\xff\xfa\x27\x01\x03DEVNAME\xff\xf0"))
+			Expect(exchange("\xff\xfa\x27\x00\x03DEVNAME\x01LU42\xff\xf0\xff\xfb\x18")).To(Equal("\xff\xfa\x18\x01\xff\xf0"))
+			Expect(exchange("\xff\xfa\x18\x00IBM-3278-2-E\xff\xf0\xff\xfb\x28")).To(Equal("\xff\xfa\x28\x08\x02\xff\xf0"))
+			Expect(exchange("\xff\xfa\x28\x02\x07IBM-3278-2-E\xff\xf0")).To(HaveSuffix("\x01LU42\xff\xf0"))
+		})
+
+		It("Should drop the records sent before the device type is negotiated", func() {
+			server = &tn3270.Server{Handler: &MyHandler{}}
+			defer server.Close()
+			conn, peer := net.Pipe()
+			defer conn.Close()
+			go server.ServeConn(peer)
+			buf := make([]byte, 64)
+			exchange := func(data string) string {
+				go conn.Write([]byte(data))
+				n, _ := conn.Read(buf)
+				return string(buf[:n])
+			}
+			n, _ := conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x18}))
+			n, _ = conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x28}))
+			Expect(exchange("\xff\xfb\x28")).To(Equal("\xff\xfa\x28\x08\x02\xff\xf0"))
+			conn.Write([]byte("\x00\x00\x00\x00\x01\x7d\x40\x40\xff\xef"))
+			Expect(exchange("\xff\xfa\x28\x02\x07IBM-3278-2-E\xff\xf0")).To(HavePrefix("\xff\xfa\x28\x02\x04"))
+		})
+
+		It("Should let servers refuse unsupported options", func() {
+			server = &tn3270.Server{Handler: &MyHandler{}}
+			defer server.Close()
+			conn, peer := net.Pipe()
+			defer conn.Close()
+			go server.ServeConn(peer)
+			buf := make([]byte, 16)
+			n, _ := conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x18}))
+			n, _ = conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x28}))
+			go conn.Write([]byte{0xff, 0xfb, 0x01}) // WILL ECHO
+			n, _ = conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfe, 0x01}))
+			go conn.Write([]byte{0xff, 0xfb, 0x28})
+			n, _ = conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfa, 0x28, 0x08, 0x02, 0xff, 0xf0}))
+		})
+	})
+
+	Describe("START_TLS", func() {
+		var config *tls.Config
+
+		BeforeEach(func() {
+			cert, err := tls.X509KeyPair([]byte(certPem), []byte(keyPem))
+			Expect(err).To(Succeed())
+			server = &tn3270.Server{Handler: &MyHandler{}, TLSConfig: &tls.Config{Certificates: []tls.Certificate{cert}}}
+			config = &tls.Config{InsecureSkipVerify: true}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		connect := func(client *tn3270.Client) (chan string, *sniffConn, error) {
+			conn, host := net.Pipe()
+			sniffer := &sniffConn{Conn: host}
+			go server.ServeConn(sniffer)
+			recv, err := client.ConnectConn(conn)
+			return recv, sniffer, err
+		}
+
+		It("Should upgrade the connection to TLS", func() {
+			server.StartTLS = tn3270.StartTLSRequired
+			client := tn3270.NewClient("")
+			client.StartTLS = tn3270.StartTLSOptional
+			client.TLSConfig = config
+			recv, sniffer, err := connect(client)
+			Expect(err).To(Succeed())
+			Expect(<-recv).To(Equal("WELCOME TO MY TN3270 SERVER"))
+			Expect(client.SendRecv("Hello")).To(Equal("ECHO: Hello"))
+			received := sniffer.Received()
+			Expect(received).To(HavePrefix("\xff\xfb\x2e\xff\xfa\x2e\x01\xff\xf0\x16\x03"))
+			Expect(received).NotTo(ContainSubstring("\xc8\x85\x93\x93\x96")) // Hello
+		})
+
+		It("Should go on in plain text if TLS is optional", func() {
+			server.StartTLS = tn3270.StartTLSOptional
+			recv, _, err := connect(tn3270.NewClient(""))
+			Expect(err).To(Succeed())
+			Expect(<-recv).To(Equal("WELCOME TO MY TN3270 SERVER"))
+
+			server.StartTLS = tn3270.StartTLSDisabled
+			client := tn3270.NewClient("")
+			client.StartTLS = tn3270.StartTLSOptional
+			recv, _, err = connect(client)
+			Expect(err).To(Succeed())
+			Expect(<-recv).To(Equal("WELCOME TO MY TN3270 SERVER"))
+		})
+
+		It("Should end the connection if TLS is required", func() {
+			server.StartTLS = tn3270.StartTLSRequired
+			_, _, err := connect(tn3270.NewClient(""))
+			Expect(err).To(Equal(tn3270.ErrConnectionLost))
+
+			server.StartTLS = tn3270.StartTLSDisabled
+			client := tn3270.NewClient("")
+			client.StartTLS = tn3270.StartTLSRequired
+			_, _, err = connect(client)
+			Expect(err).To(Equal(tn3270.ErrTLSRequired))
+		})
+
+		It("Should end the connection if the host sends data in plain text", func() {
+			client := tn3270.NewClient("")
+			client.StartTLS = tn3270.StartTLSRequired
+			conn, host := net.Pipe()
+			defer host.Close()
+			go io.Copy(ioutil.Discard, host)
+			go func() {
+				host.Write([]byte{0xff, 0xfd, 0x28})
+				host.Write([]byte("\x07\x00\x00\x00\x00\xc8\xc9\xff\xef")) // SSCP-LU data
+			}()
+			_, err := client.ConnectConn(conn)
+			Expect(err).To(Equal(tn3270.ErrTLSRequired))
+			Eventually(client.Done()).Should(BeClosed())
+		})
+
+		It("Should go on in plain text if the terminal does not answer START_TLS", func() {
+			server.StartTLS = tn3270.StartTLSOptional
+			server.StartTLSTimeout = 50 * time.Millisecond
+			conn, host := net.Pipe()
+			defer conn.Close()
+			go server.ServeConn(host)
+			buf := make([]byte, 16)
+			n, _ := conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x2e}))
+			n, _ = conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x18}))
+			n, _ = conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x28}))
+		})
+
+		It("Should refuse TN3270E before TLS if TLS is required", func() {
+			server.StartTLS = tn3270.StartTLSRequired
+			conn, host := net.Pipe()
+			defer conn.Close()
+			go server.ServeConn(host)
+			buf := make([]byte, 16)
+			n, _ := conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfd, 0x2e}))
+			go conn.Write([]byte{0xff, 0xfb, 0x28})
+			n, _ = conn.Read(buf)
+			Expect(buf[:n]).To(Equal([]byte{0xff, 0xfe, 0x28}))
+		})
+	})
+
+	Describe("Client certificates", func() {
+		var cert tls.Certificate
+		var sessions chan *tn3270.Request
+
+		BeforeEach(func() {
+			var err error
+			cert, err = tls.X509KeyPair([]byte(certPem), []byte(keyPem))
+			Expect(err).To(Succeed())
+			sessions = make(chan *tn3270.Request, 1)
+			server = &tn3270.Server{
+				Handler: tn3270.HandlerFunc(func(w tn3270.ResponseWriter, r *tn3270.Request) {
+					sessions <- r
+				}),
+				MapCertificate: func(c *x509.Certificate) (string, string, bool) {
+					return "TERM07", "USER-" + c.Subject.CommonName, c.Subject.CommonName == "localhost"
+				},
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		connect := func(client *tn3270.Client, certs []tls.Certificate) error {
+			conn, host := net.Pipe()
+			go server.ServeConn(tls.Server(host, &tls.Config{Certificates: []tls.Certificate{cert}, ClientAuth: tls.RequireAnyClientCert}))
+			_, err := client.ConnectConn(tls.Client(conn, &tls.Config{InsecureSkipVerify: true, Certificates: certs}))
+			return err
+		}
+
+		It("Should assign the LU and the user of the certificate", func() {
+			client := tn3270.NewClient("")
+			defer client.Close()
+			Expect(connect(client, []tls.Certificate{cert})).To(Succeed())
+			Expect(client.LUName()).To(Equal("TERM07"))
+			<-client.Send("")
+			r := <-sessions
+			Expect(r.LUName).To(Equal("TERM07"))
+			Expect(r.Session.User).To(Equal("USER-localhost"))
+			Expect(r.Session.Certificate.Subject.CommonName).To(Equal("localhost"))
+		})
+
+		It("Should reject the terminals not allowed", func() {
+			client := tn3270.NewClient("TERM01")
+			Expect(connect(client, []tls.Certificate{cert})).To(Equal(&tn3270.NegotiationError{LU: "TERM01", Reason: tn3270.ReasonInvName}))
+
+			server.MapCertificate = func(*x509.Certificate) (string, string, bool) {
+				return "", "", false
+			}
+			client = tn3270.NewClient("")
+			Expect(connect(client, []tls.Certificate{cert})).To(Equal(&tn3270.NegotiationError{Reason: tn3270.ReasonInvName}))
+		})
+
+		It("Should reject the printers associating with another LU", func() {
+			printer := tn3270.NewClient("")
+			printer.Associate = "TERM01"
+			err := connect(printer, []tls.Certificate{cert})
+			Expect(err).To(Equal(&tn3270.NegotiationError{LU: "TERM01", Reason: tn3270.ReasonInvName}))
+		})
+
+		It("Should reject the terminals without certificate", func() {
+			server.MapCertificate = func(*x509.Certificate) (string, string, bool) {
+				return "", "", true
+			}
+			conn, host := net.Pipe()
+			go server.ServeConn(host)
+			_, err := tn3270.NewClient("").ConnectConn(conn)
+			Expect(err).To(Equal(&tn3270.NegotiationError{Reason: tn3270.ReasonInvName}))
+		})
+	})
 })
+
+// sniffConn keeps the data received by a connection
+type sniffConn struct {
+	net.Conn
+	mu       sync.Mutex
+	received []byte
+}
+
+func (c *sniffConn) Read(p []byte) (int, error) {
+	n, err := c.Conn.Read(p)
+	c.mu.Lock()
+	c.received = append(c.received, p[:n]...)
+	c.mu.Unlock()
+	return n, err
+}
+
+func (c *sniffConn) Received() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return string(c.received)
+}
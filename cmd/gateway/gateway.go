@@ -0,0 +1,429 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+var (
+	errTooManySessions = errors.New("too many sessions")
+	errNoSession       = errors.New("no such session")
+	errTimeout         = errors.New("timed out waiting for the host")
+	errDisconnected    = errors.New("disconnected from the host")
+)
+
+// gateway exposes host sessions over HTTP:
+//
+//	POST   /sessions                 open a session, {"lu": "..."} optional
+//	GET    /sessions/{id}            get the screen
+//	POST   /sessions/{id}/fields     fill fields, [{"index": 1, "value": "..."}]
+//	                                 or [{"row": 0, "col": 11, "value": "..."}]
+//	POST   /sessions/{id}/keys/{key} press Enter, Clear, PA1-PA3 or PF1-PF24
+//	DELETE /sessions/{id}            close the session
+type gateway struct {
+	Host        string           // host all sessions connect to, host:port
+	CodePage    *tn3270.CodePage // CP037 if nil
+	MaxSessions int              // no limit if 0
+	IdleTimeout time.Duration    // sessions unused for longer are closed, never if 0
+	Timeout     time.Duration    // of the exchanges with the host
+
+	// Pool keeps sessions connected in advance, the sessions opened
+	// without LU are taken from it, and discarded once closed
+	Pool *tn3270.Pool
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	opening  int // sessions being opened, counted against MaxSessions
+}
+
+// session is a client and the screens it received
+type session struct {
+	client  *tn3270.Client
+	pool    *tn3270.Pool // the client is discarded to it if not nil
+	used    time.Time    // last request, protected by gateway.mu
+	mu      sync.Mutex
+	updates int           // screens received
+	changed chan struct{} // closed and replaced at each screen
+	closed  bool
+}
+
+func newSession(client *tn3270.Client, recv chan string) *session {
+	s := &session{client: client, used: time.Now(), changed: make(chan struct{})}
+	go func() {
+		for {
+			select {
+			case <-recv:
+			case <-client.Done():
+				s.mu.Lock()
+				s.closed = true
+				close(s.changed)
+				s.mu.Unlock()
+				return
+			}
+			s.mu.Lock()
+			s.updates++
+			close(s.changed)
+			s.changed = make(chan struct{})
+			s.mu.Unlock()
+		}
+	}()
+	return s
+}
+
+// close ends the session, the pool replaces its pooled sessions
+func (s *session) close() {
+	if s.pool != nil {
+		s.pool.Discard(s.client)
+		return
+	}
+	s.client.Close()
+}
+
+// waitScreen waits until more than n screens were received
+func (s *session) waitScreen(n int, timeout time.Duration) error {
+	deadline := time.After(timeout)
+	for {
+		s.mu.Lock()
+		updates, closed, ch := s.updates, s.closed, s.changed
+		s.mu.Unlock()
+		switch {
+		case updates > n:
+			return nil
+		case closed:
+			return errDisconnected
+		}
+		select {
+		case <-ch:
+		case <-deadline:
+			return errTimeout
+		}
+	}
+}
+
+func (s *session) screens() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.updates
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if parts[0] != "sessions" {
+		http.NotFound(w, r)
+		return
+	}
+	switch {
+	case len(parts) == 1 && r.Method == http.MethodPost:
+		g.open(w, r)
+	case len(parts) == 2 && r.Method == http.MethodGet:
+		g.withSession(w, parts[1], g.screen)
+	case len(parts) == 2 && r.Method == http.MethodDelete:
+		g.close(w, parts[1])
+	case len(parts) == 3 && parts[2] == "fields" && r.Method == http.MethodPost:
+		g.withSession(w, parts[1], func(w http.ResponseWriter, s *session) {
+			g.fill(w, r, s)
+		})
+	case len(parts) == 4 && parts[2] == "keys" && r.Method == http.MethodPost:
+		g.withSession(w, parts[1], func(w http.ResponseWriter, s *session) {
+			g.key(w, parts[3], s)
+		})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func (g *gateway) open(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		LU string `json:"lu"`
+	}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	// The slot is reserved before connecting so that concurrent requests
+	// do not exceed MaxSessions
+	g.mu.Lock()
+	if g.sessions == nil {
+		g.sessions = make(map[string]*session)
+	}
+	if g.MaxSessions > 0 && len(g.sessions)+g.opening >= g.MaxSessions {
+		g.mu.Unlock()
+		writeError(w, http.StatusServiceUnavailable, errTooManySessions)
+		return
+	}
+	g.opening++
+	g.mu.Unlock()
+
+	s, status, err := g.connect(req.LU)
+	id := newID()
+	g.mu.Lock()
+	g.opening--
+	if err == nil {
+		g.sessions[id] = s
+	}
+	g.mu.Unlock()
+	if err != nil {
+		writeError(w, status, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, struct {
+		ID     string      `json:"id"`
+		Screen *screenJSON `json:"screen"`
+	}{id, newScreenJSON(s.client.Screen())})
+}
+
+// connect opens a session on its welcome screen, it returns the HTTP
+// status of the failure if it cannot
+func (g *gateway) connect(lu string) (*session, int, error) {
+	if g.Pool != nil && lu == "" {
+		ctx, cancel := context.WithTimeout(context.Background(), g.Timeout)
+		defer cancel()
+		client, err := g.Pool.Acquire(ctx)
+		switch {
+		case err == context.DeadlineExceeded:
+			return nil, http.StatusGatewayTimeout, errTimeout
+		case err != nil:
+			return nil, http.StatusBadGateway, err
+		}
+		s := newSession(client, client.Screens())
+		s.pool = g.Pool
+		return s, 0, nil
+	}
+	client := tn3270.NewClient(lu)
+	client.CodePage = g.CodePage
+	recv, err := client.Connect(g.Host)
+	if err != nil {
+		return nil, http.StatusBadGateway, err
+	}
+	s := newSession(client, recv)
+	if err := s.waitScreen(0, g.Timeout); err != nil {
+		client.Close()
+		return nil, http.StatusGatewayTimeout, err
+	}
+	return s, 0, nil
+}
+
+func (g *gateway) withSession(w http.ResponseWriter, id string, f func(http.ResponseWriter, *session)) {
+	g.mu.Lock()
+	s, ok := g.sessions[id]
+	if ok {
+		s.used = time.Now()
+	}
+	g.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, errNoSession)
+		return
+	}
+	f(w, s)
+}
+
+func (g *gateway) screen(w http.ResponseWriter, s *session) {
+	writeJSON(w, http.StatusOK, newScreenJSON(s.client.Screen()))
+}
+
+func (g *gateway) close(w http.ResponseWriter, id string) {
+	g.mu.Lock()
+	s, ok := g.sessions[id]
+	delete(g.sessions, id)
+	g.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, errNoSession)
+		return
+	}
+	s.close()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// fieldInput is a value to type in a field, given by index or position
+type fieldInput struct {
+	Index *int   `json:"index"`
+	Row   int    `json:"row"`
+	Col   int    `json:"col"`
+	Value string `json:"value"`
+}
+
+// fill replaces the content of fields
+func (g *gateway) fill(w http.ResponseWriter, r *http.Request, s *session) {
+	var inputs []fieldInput
+	if err := json.NewDecoder(r.Body).Decode(&inputs); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	for _, in := range inputs {
+		row, col := in.Row, in.Col
+		if in.Index != nil {
+			screen := s.client.Screen()
+			fields := screen.Fields()
+			if *in.Index < 0 || *in.Index >= len(fields) {
+				writeError(w, http.StatusBadRequest, errors.New("no such field"))
+				return
+			}
+			row, col = fields[*in.Index].Addr/screen.Cols(), fields[*in.Index].Addr%screen.Cols()
+		}
+		s.client.MoveCursor(row, col)
+		err := s.client.EraseEOF()
+		if err == nil {
+			err = s.client.Type(in.Value)
+		}
+		if err != nil {
+			writeError(w, http.StatusConflict, err)
+			return
+		}
+	}
+	g.screen(w, s)
+}
+
+// key presses a key and waits for the answer of the host
+func (g *gateway) key(w http.ResponseWriter, name string, s *session) {
+	aid, ok := tn3270.ParseAID(name)
+	if !ok {
+		writeError(w, http.StatusBadRequest, errors.New("unknown key "+name))
+		return
+	}
+	if s.client.Screen().KeyboardLocked() {
+		writeError(w, http.StatusConflict, tn3270.ErrKeyboardLocked)
+		return
+	}
+	n := s.screens()
+	s.client.SendAID(aid)
+	if err := s.waitScreen(n, g.Timeout); err != nil {
+		writeError(w, http.StatusGatewayTimeout, err)
+		return
+	}
+	g.screen(w, s)
+}
+
+// reap closes the sessions idle for longer than IdleTimeout, and the ones
+// the host disconnected
+func (g *gateway) reap() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for id, s := range g.sessions {
+		s.mu.Lock()
+		closed := s.closed
+		s.mu.Unlock()
+		if closed || (g.IdleTimeout > 0 && time.Since(s.used) > g.IdleTimeout) {
+			s.close()
+			delete(g.sessions, id)
+		}
+	}
+}
+
+type positionJSON struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+type fieldJSON struct {
+	positionJSON
+	Index     int    `json:"index"`
+	Length    int    `json:"length"`
+	Protected bool   `json:"protected"`
+	Hidden    bool   `json:"hidden"`
+	Numeric   bool   `json:"numeric"`
+	Modified  bool   `json:"modified"`
+	Value     string `json:"value"`
+}
+
+type screenJSON struct {
+	Rows           int          `json:"rows"`
+	Cols           int          `json:"cols"`
+	Cursor         positionJSON `json:"cursor"`
+	KeyboardLocked bool         `json:"keyboardLocked"`
+	Text           []string     `json:"text"`
+	Fields         []fieldJSON  `json:"fields"`
+}
+
+func newScreenJSON(screen *tn3270.VirtualScreenTN3270Handler) *screenJSON {
+	cols := screen.Cols()
+	j := &screenJSON{
+		Rows:           screen.Rows(),
+		Cols:           cols,
+		Cursor:         positionJSON{screen.Cursor() / cols, screen.Cursor() % cols},
+		KeyboardLocked: screen.KeyboardLocked(),
+		Fields:         []fieldJSON{},
+	}
+	var row strings.Builder
+	cells := screen.Cells()
+	hidden := false
+	// The first positions belong to the last field of the buffer
+	for i := len(cells) - 1; i >= 0; i-- {
+		if cells[i].FieldStart {
+			hidden = cells[i].Attr&tn3270.FieldHidden == tn3270.FieldHidden
+			break
+		}
+	}
+	for i, c := range cells {
+		switch {
+		case c.FieldStart:
+			hidden = c.Attr&tn3270.FieldHidden == tn3270.FieldHidden
+			row.WriteRune(' ')
+		case hidden:
+			row.WriteRune(' ')
+		case c.Char != 0:
+			row.WriteRune(c.Char)
+		}
+		if (i+1)%cols == 0 {
+			j.Text = append(j.Text, row.String())
+			row.Reset()
+		}
+	}
+	for i, f := range screen.Fields() {
+		value := f.Value
+		if f.Hidden() {
+			value = ""
+		}
+		j.Fields = append(j.Fields, fieldJSON{
+			positionJSON: positionJSON{f.Addr / cols, f.Addr % cols},
+			Index:        i,
+			Length:       len([]rune(f.Value)),
+			Protected:    f.Protected(),
+			Hidden:       f.Hidden(),
+			Numeric:      f.Numeric(),
+			Modified:     f.Modified(),
+			Value:        strings.TrimRight(value, " "),
+		})
+	}
+	return j
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+	"github.com/wuzuf/go-tn3270/tn3270test"
+)
+
+func TestSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Gateway Suite")
+}
+
+var _ = Describe("Gateway", func() {
+	var host *tn3270test.Host
+	var g *gateway
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		host = tn3270test.NewHost(
+			tn3270test.Step{Write: tn3270test.LogonScreen},
+			tn3270test.Step{
+				Expect: &tn3270test.Input{AID: tn3270.AIDEnter, Fields: map[int]string{11: "JDOE"}},
+				Screen: "READY",
+			},
+		)
+		g = &gateway{Host: host.Addr, MaxSessions: 1, Timeout: time.Second}
+		server = httptest.NewServer(g)
+	})
+
+	AfterEach(func() {
+		server.Close()
+		host.Close()
+	})
+
+	call := func(method, path, body string, status int, v interface{}) {
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		Expect(err).To(Succeed())
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).To(Succeed())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(status))
+		if v != nil {
+			Expect(json.NewDecoder(resp.Body).Decode(v)).To(Succeed())
+		}
+	}
+
+	It("Should drive a session", func() {
+		var opened struct {
+			ID     string
+			Screen screenJSON
+		}
+		call("POST", "/sessions", "", http.StatusCreated, &opened)
+		Expect(opened.ID).NotTo(BeEmpty())
+		Expect(opened.Screen.Cursor).To(Equal(positionJSON{0, 11}))
+		Expect(opened.Screen.Text[0]).To(HavePrefix(" USER"))
+		Expect(opened.Screen.Text[0]).NotTo(ContainSubstring("SECRET"))
+		Expect(opened.Screen.Fields).To(HaveLen(5))
+		Expect(opened.Screen.Fields[1].Value).To(BeEmpty())
+		Expect(opened.Screen.Fields[3].Hidden).To(BeTrue())
+		Expect(opened.Screen.Fields[3].Value).To(BeEmpty())
+		Expect(opened.Screen.Fields[1].Protected).To(BeFalse())
+		Expect(opened.Screen.Fields[1].Length).To(Equal(9))
+
+		call("POST", "/sessions", "", http.StatusServiceUnavailable, nil)
+
+		var screen screenJSON
+		call("POST", "/sessions/"+opened.ID+"/fields", `[{"index": 1, "value": "JDOE"}]`, http.StatusOK, &screen)
+		Expect(screen.Fields[1].Value).To(Equal("JDOE"))
+		Expect(screen.Fields[1].Modified).To(BeTrue())
+		call("POST", "/sessions/"+opened.ID+"/fields", `[{"row": 0, "col": 1, "value": "X"}]`, http.StatusConflict, nil)
+
+		call("POST", "/sessions/"+opened.ID+"/keys/enter", "", http.StatusOK, &screen)
+		Expect(screen.Text[1]).To(HavePrefix("READY"))
+		Expect(screen.Fields).To(BeEmpty())
+		Expect(host.Err()).To(Succeed())
+
+		call("GET", "/sessions/"+opened.ID, "", http.StatusOK, &screen)
+		call("POST", "/sessions/"+opened.ID+"/keys/F13", "", http.StatusBadRequest, nil)
+		call("DELETE", "/sessions/"+opened.ID, "", http.StatusNoContent, nil)
+		call("GET", "/sessions/"+opened.ID, "", http.StatusNotFound, nil)
+	})
+
+	It("Should not open more than MaxSessions at once", func() {
+		statuses := make(chan int)
+		for i := 0; i < 5; i++ {
+			go func() {
+				resp, err := http.Post(server.URL+"/sessions", "application/json", nil)
+				if err != nil {
+					statuses <- 0
+					return
+				}
+				resp.Body.Close()
+				statuses <- resp.StatusCode
+			}()
+		}
+		created := 0
+		for i := 0; i < 5; i++ {
+			if <-statuses == http.StatusCreated {
+				created++
+			}
+		}
+		Expect(created).To(Equal(1))
+	})
+
+	It("Should take the sessions without LU from the pool", func() {
+		g.Pool = &tn3270.Pool{
+			Dial: func() (net.Conn, error) { return host.Pipe(), nil },
+			Size: 1,
+		}
+		defer g.Pool.Close()
+		var opened struct {
+			ID     string
+			Screen screenJSON
+		}
+		call("POST", "/sessions", "", http.StatusCreated, &opened)
+		Expect(opened.Screen.Cursor).To(Equal(positionJSON{0, 11}))
+		Expect(g.Pool.Idle()).To(Equal(0))
+
+		var screen screenJSON
+		call("POST", "/sessions/"+opened.ID+"/fields", `[{"index": 1, "value": "JDOE"}]`, http.StatusOK, &screen)
+		call("POST", "/sessions/"+opened.ID+"/keys/enter", "", http.StatusOK, &screen)
+		Expect(screen.Text[1]).To(HavePrefix("READY"))
+		call("DELETE", "/sessions/"+opened.ID, "", http.StatusNoContent, nil)
+		Eventually(g.Pool.Idle).Should(Equal(1))
+	})
+
+	It("Should close idle sessions", func() {
+		g.IdleTimeout = time.Millisecond
+		var opened struct{ ID string }
+		call("POST", "/sessions", `{"lu": "LU01"}`, http.StatusCreated, &opened)
+		time.Sleep(5 * time.Millisecond)
+		g.reap()
+		call("GET", "/sessions/"+opened.ID, "", http.StatusNotFound, nil)
+	})
+})
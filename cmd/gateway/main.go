@@ -0,0 +1,63 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command gateway exposes sessions to a TN3270 host as an HTTP/JSON API, so
+// that web services can use the host without embedding a 3270 client.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+var (
+	listen      = flag.String("listen", ":8080", "HTTP `address` to listen on.")
+	host        = flag.String("host", "", "TN3270 host to connect to, `host:port`.")
+	codePage    = flag.String("codepage", "cp037", "EBCDIC code page of the host.")
+	maxSessions = flag.Int("max-sessions", 100, "Maximum number of open sessions, 0 for no limit.")
+	idleTimeout = flag.Duration("idle-timeout", 10*time.Minute, "Close sessions idle for longer, 0 to keep them.")
+	timeout     = flag.Duration("timeout", 30*time.Second, "Time to wait for the host to answer.")
+	pool        = flag.Int("pool", 0, "Number of sessions connected in advance, 0 for none.")
+)
+
+func main() {
+	flag.Parse()
+	if *host == "" {
+		log.Fatal("-host is required")
+	}
+	cp := tn3270.LookupCodePage(*codePage)
+	if cp == nil {
+		log.Fatalf("unknown code page %q", *codePage)
+	}
+	g := &gateway{
+		Host:        *host,
+		CodePage:    cp,
+		MaxSessions: *maxSessions,
+		IdleTimeout: *idleTimeout,
+		Timeout:     *timeout,
+	}
+	if *pool > 0 {
+		g.Pool = &tn3270.Pool{Addr: *host, CodePage: cp, Size: *pool, Timeout: *timeout}
+	}
+	go func() {
+		for range time.Tick(time.Minute) {
+			g.reap()
+		}
+	}()
+	log.Fatal(http.ListenAndServe(*listen, g))
+}
@@ -0,0 +1,112 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command s3270 is a headless TN3270 client driven by s3270-compatible
+// actions, read one per line on stdin or on a socket:
+//
+//	Connect([lu@]host[:port])  Disconnect  Quit
+//	String(text)  Enter  Clear  Tab  PF(n)  PA(n)  MoveCursor(row, col)
+//	Wait([timeout,] InputField|Unlock|Output|Disconnect)
+//	Ascii  Ascii(row, col, length)  Ascii(row, col, rows, cols)
+//	ReadBuffer(Ascii)
+//
+// Each action is answered with its data lines ("data: ..."), the status
+// line and "ok" or "error", like s3270 does.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"strings"
+)
+
+var (
+	scriptPort = flag.Int("scriptport", 0, "Read actions from a TCP `port` on localhost instead of stdin.")
+	socketPath = flag.String("socket", "", "Read actions from a Unix socket at `path` instead of stdin.")
+	codePage   = flag.String("codepage", "cp037", "EBCDIC code page of the host.")
+	traceFile  = flag.String("trace", "", "Write a protocol trace to `file`.")
+)
+
+func main() {
+	flag.Parse()
+
+	s, err := newSession(*codePage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *traceFile != "" {
+		f, err := os.Create(*traceFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		s.trace = f
+	}
+	if flag.NArg() > 0 {
+		if err := s.connect(flag.Arg(0)); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var l net.Listener
+	switch {
+	case *scriptPort != 0:
+		l, err = net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", *scriptPort))
+	case *socketPath != "":
+		l, err = net.Listen("unix", *socketPath)
+	default:
+		s.run(os.Stdin, os.Stdout)
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer l.Close()
+	// Serve one script connection at a time, all sharing the session
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			log.Fatal(err)
+		}
+		quit := s.run(conn, conn)
+		conn.Close()
+		if quit {
+			return
+		}
+	}
+}
+
+// run executes the actions read from r until the end of input or Quit,
+// which makes it return true
+func (s *session) run(r io.Reader, w io.Writer) bool {
+	scanner := bufio.NewScanner(r)
+	out := bufio.NewWriter(w)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		quit := s.execute(line, out)
+		out.Flush()
+		if quit {
+			return true
+		}
+	}
+	return false
+}
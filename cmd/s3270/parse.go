@@ -0,0 +1,83 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// parseAction splits an action line, either "Name(arg, arg)" or
+// "Name arg arg". Arguments may be double quoted, quoted arguments accept
+// the \n, \t, \" and \\ escapes.
+func parseAction(line string) (string, []string, error) {
+	i := strings.IndexFunc(line, func(r rune) bool {
+		return r == '(' || unicode.IsSpace(r)
+	})
+	if i == -1 {
+		return line, nil, nil
+	}
+	name, rest := line[:i], strings.TrimSpace(line[i:])
+	if strings.HasPrefix(rest, "(") {
+		if !strings.HasSuffix(rest, ")") {
+			return "", nil, errors.New("missing )")
+		}
+		rest = rest[1 : len(rest)-1]
+	}
+	args, err := splitArgs(rest)
+	return name, args, err
+}
+
+func splitArgs(s string) ([]string, error) {
+	var args []string
+	var arg strings.Builder
+	inArg, quoted, escaped := false, false, false
+	for _, r := range s {
+		switch {
+		case escaped:
+			switch r {
+			case 'n':
+				arg.WriteRune('\n')
+			case 't':
+				arg.WriteRune('\t')
+			default:
+				arg.WriteRune(r)
+			}
+			escaped = false
+		case quoted && r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+			inArg = true
+		case !quoted && (r == ',' || unicode.IsSpace(r)):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quoted {
+		return nil, errors.New("missing \"")
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
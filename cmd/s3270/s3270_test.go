@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+	"github.com/wuzuf/go-tn3270/tn3270test"
+)
+
+func TestSuite(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "s3270 Suite")
+}
+
+var _ = Describe("s3270", func() {
+	var host *tn3270test.Host
+	var s *session
+
+	BeforeEach(func() {
+		host = tn3270test.NewHost(
+			tn3270test.Step{Write: tn3270test.LogonScreen},
+			tn3270test.Step{
+				Expect: &tn3270test.Input{AID: tn3270.AIDEnter, Fields: map[int]string{11: "JDOE"}},
+				Screen: "READY",
+			},
+		)
+		var err error
+		s, err = newSession("cp037")
+		Expect(err).To(Succeed())
+	})
+
+	AfterEach(func() {
+		s.disconnect()
+		host.Close()
+	})
+
+	// run executes a script and returns the output lines
+	run := func(script string) []string {
+		var out bytes.Buffer
+		s.run(strings.NewReader(script), &out)
+		return strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	}
+
+	It("Should parse actions", func() {
+		name, args, err := parseAction(`String("a, \"b\"\n")`)
+		Expect(err).To(Succeed())
+		Expect(name).To(Equal("String"))
+		Expect(args).To(Equal([]string{"a, \"b\"\n"}))
+		name, args, err = parseAction("MoveCursor 1 2")
+		Expect(err).To(Succeed())
+		Expect(name).To(Equal("MoveCursor"))
+		Expect(args).To(Equal([]string{"1", "2"}))
+		_, _, err = parseAction("PF(3")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should run a logon script", func() {
+		out := run("Connect(" + host.Addr + ")\nWait(InputField)\n")
+		Expect(out).To(HaveLen(4))
+		Expect(out[0]).To(MatchRegexp(`^U U U C\(127.0.0.1\) I 2 24 80 0 0 0x0 [0-9.]+$`))
+		Expect(out[1]).To(Equal("ok"))
+		Expect(out[2]).To(MatchRegexp(`^U F U C\(127.0.0.1\) I 2 24 80 0 11 0x0 `))
+		Expect(out[3]).To(Equal("ok"))
+
+		out = run("Ascii(0, 0, 20)\nReadBuffer(Ascii)\n")
+		Expect(out[0]).To(Equal("data:  USER               "))
+		Expect(out[3]).To(HavePrefix("data: SF(c0=60) 55 53 45 52 20"))
+		Expect(out[3]).To(ContainSubstring("SF(c0=40)"))
+		Expect(strings.Fields(strings.TrimPrefix(out[3], "data: "))).To(HaveLen(80))
+		Expect(out[len(out)-1]).To(Equal("ok"))
+
+		out = run(`String("JDOE\n")` + "\nAscii\n")
+		Expect(out[1]).To(Equal("ok"))
+		Expect(out[3]).To(Equal("data: READY" + strings.Repeat(" ", 75)))
+		Expect(host.Err()).To(Succeed())
+		Expect(host.Done()).To(BeTrue())
+
+		out = run("Disconnect\nEnter\n")
+		Expect(out[0]).To(HavePrefix("U U U N N "))
+		Expect(out[2]).To(Equal("data: Not connected"))
+		Expect(out[4]).To(Equal("error"))
+	})
+})
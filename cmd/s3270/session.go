@@ -0,0 +1,462 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+var (
+	errNotConnected = errors.New("Not connected")
+	errConnected    = errors.New("Already connected")
+	errLocked       = errors.New("Keyboard locked")
+	errTimeout      = errors.New("Wait timed out")
+)
+
+// session is the emulator state shared by all the script connections
+type session struct {
+	cp      *tn3270.CodePage
+	trace   io.Writer
+	timeout time.Duration // of Wait and of the actions waiting for the host
+
+	mu      sync.Mutex
+	client  *tn3270.Client
+	host    string
+	updates int           // screens received since the connection
+	changed chan struct{} // closed and replaced at each screen or disconnection
+}
+
+func newSession(codePage string) (*session, error) {
+	cp := tn3270.LookupCodePage(codePage)
+	if cp == nil {
+		return nil, fmt.Errorf("unknown code page %q", codePage)
+	}
+	return &session{cp: cp, timeout: 30 * time.Second, changed: make(chan struct{})}, nil
+}
+
+// notify wakes up the actions waiting for the host, s.mu must be held
+func (s *session) notify() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// watch follows the screens sent by the host until the connection is lost
+func (s *session) watch(c *tn3270.Client, recv chan string) {
+	for {
+		select {
+		case <-recv:
+			s.mu.Lock()
+			s.updates++
+			s.notify()
+			s.mu.Unlock()
+		case <-c.Done():
+			s.mu.Lock()
+			if s.client == c {
+				s.client = nil
+				s.host = ""
+			}
+			s.notify()
+			s.mu.Unlock()
+			return
+		}
+	}
+}
+
+// connect opens a session to "[lu@]host[:port]"
+func (s *session) connect(target string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != nil {
+		return errConnected
+	}
+	lu := ""
+	if i := strings.Index(target, "@"); i != -1 {
+		lu, target = target[:i], target[i+1:]
+	}
+	host := target
+	if _, _, err := net.SplitHostPort(target); err != nil {
+		target = net.JoinHostPort(target, "23")
+	} else {
+		host, _, _ = net.SplitHostPort(target)
+	}
+	c := tn3270.NewClient(lu)
+	c.CodePage = s.cp
+	if s.trace != nil {
+		c.Trace = tn3270.TraceWriter(s.trace)
+	}
+	recv, err := c.Connect(target)
+	if err != nil {
+		return err
+	}
+	s.client = c
+	s.host = host
+	s.updates = 0
+	go s.watch(c, recv)
+	return nil
+}
+
+func (s *session) disconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+		s.host = ""
+		s.notify()
+	}
+}
+
+func (s *session) current() (*tn3270.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client == nil {
+		return nil, errNotConnected
+	}
+	return s.client, nil
+}
+
+// wait waits until cond holds, cond is called with s.mu held
+func (s *session) wait(timeout time.Duration, cond func() bool) error {
+	deadline := time.After(timeout)
+	for {
+		s.mu.Lock()
+		ch := s.changed
+		ok := cond()
+		s.mu.Unlock()
+		if ok {
+			return nil
+		}
+		select {
+		case <-ch:
+		case <-deadline:
+			return errTimeout
+		}
+	}
+}
+
+// aid sends an AID and waits for the answer of the host
+func (s *session) aid(aid tn3270.AID) error {
+	c, err := s.current()
+	if err != nil {
+		return err
+	}
+	if c.Screen().KeyboardLocked() {
+		return errLocked
+	}
+	s.mu.Lock()
+	updates := s.updates
+	s.mu.Unlock()
+	c.SendAID(aid)
+	return s.wait(s.timeout, func() bool {
+		return s.client != c || s.updates > updates
+	})
+}
+
+// execute runs an action and writes its result, it returns true on Quit
+func (s *session) execute(line string, w io.Writer) bool {
+	start := time.Now()
+	name, args, err := parseAction(line)
+	var data []string
+	quit := false
+	if err == nil {
+		data, quit, err = s.do(name, args)
+	}
+	for _, d := range data {
+		fmt.Fprintf(w, "data: %s\n", d)
+	}
+	if err != nil {
+		fmt.Fprintf(w, "data: %s\n", err)
+	}
+	fmt.Fprintln(w, s.status(time.Since(start)))
+	if err != nil {
+		fmt.Fprintln(w, "error")
+	} else {
+		fmt.Fprintln(w, "ok")
+	}
+	return quit
+}
+
+func (s *session) do(name string, args []string) ([]string, bool, error) {
+	switch strings.ToLower(name) {
+	case "connect":
+		if len(args) != 1 {
+			return nil, false, errors.New("Connect requires 1 argument")
+		}
+		return nil, false, s.connect(args[0])
+	case "disconnect":
+		s.disconnect()
+		return nil, false, nil
+	case "quit", "exit":
+		s.disconnect()
+		return nil, true, nil
+	case "string":
+		return nil, false, s.typeString(strings.Join(args, ""))
+	case "enter":
+		return nil, false, s.aid(tn3270.AIDEnter)
+	case "clear":
+		return nil, false, s.aid(tn3270.AIDClear)
+	case "pf", "pa":
+		if len(args) != 1 {
+			return nil, false, fmt.Errorf("%s requires 1 argument", name)
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return nil, false, err
+		}
+		aid, ok := tn3270.ParseAID(fmt.Sprintf("%s%d", name, n))
+		if !ok {
+			return nil, false, fmt.Errorf("%s(%d) does not exist", name, n)
+		}
+		return nil, false, s.aid(aid)
+	case "tab":
+		c, err := s.current()
+		if err == nil {
+			c.Tab()
+		}
+		return nil, false, err
+	case "movecursor":
+		return nil, false, s.moveCursor(args)
+	case "wait":
+		return nil, false, s.waitAction(args)
+	case "ascii":
+		data, err := s.ascii(args)
+		return data, false, err
+	case "readbuffer":
+		data, err := s.readBuffer(args)
+		return data, false, err
+	}
+	return nil, false, fmt.Errorf("Unknown action: %s", name)
+}
+
+// typeString types text, new lines press Enter
+func (s *session) typeString(text string) error {
+	c, err := s.current()
+	if err != nil {
+		return err
+	}
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			if err := s.aid(tn3270.AIDEnter); err != nil {
+				return err
+			}
+		}
+		if err := c.Type(line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *session) moveCursor(args []string) error {
+	c, err := s.current()
+	if err != nil {
+		return err
+	}
+	if len(args) != 2 {
+		return errors.New("MoveCursor requires 2 arguments")
+	}
+	row, err1 := strconv.Atoi(args[0])
+	col, err2 := strconv.Atoi(args[1])
+	if err1 != nil || err2 != nil {
+		return errors.New("MoveCursor requires numeric arguments")
+	}
+	c.MoveCursor(row, col)
+	return nil
+}
+
+func (s *session) waitAction(args []string) error {
+	timeout := s.timeout
+	if len(args) > 0 {
+		if seconds, err := strconv.ParseFloat(args[0], 64); err == nil {
+			timeout = time.Duration(seconds * float64(time.Second))
+			args = args[1:]
+		}
+	}
+	what := "inputfield"
+	if len(args) > 0 {
+		what = strings.ToLower(args[0])
+	}
+	s.mu.Lock()
+	updates := s.updates
+	s.mu.Unlock()
+	var cond func() bool
+	switch what {
+	case "inputfield":
+		cond = func() bool {
+			if s.client == nil {
+				return false
+			}
+			screen := s.client.Screen()
+			return !screen.KeyboardLocked() && s.updates > 0 && hasInputField(screen)
+		}
+	case "unlock":
+		cond = func() bool {
+			return s.client != nil && s.updates > 0 && !s.client.Screen().KeyboardLocked()
+		}
+	case "output":
+		cond = func() bool {
+			return s.client != nil && s.updates > updates
+		}
+	case "disconnect":
+		cond = func() bool {
+			return s.client == nil
+		}
+	default:
+		return fmt.Errorf("Unknown Wait condition: %s", args[0])
+	}
+	if what != "disconnect" {
+		if _, err := s.current(); err != nil {
+			return err
+		}
+	}
+	return s.wait(timeout, cond)
+}
+
+func hasInputField(screen *tn3270.VirtualScreenTN3270Handler) bool {
+	if !screen.Formatted() {
+		return true
+	}
+	for _, f := range screen.Fields() {
+		if !f.Protected() {
+			return true
+		}
+	}
+	return false
+}
+
+// cellText returns the text of each position of the screen
+func cellText(screen *tn3270.VirtualScreenTN3270Handler) []string {
+	cells := screen.Cells()
+	text := make([]string, len(cells))
+	for i, c := range cells {
+		switch {
+		case c.FieldStart:
+			text[i] = " "
+		case c.Char != 0:
+			text[i] = string(c.Char)
+		}
+	}
+	return text
+}
+
+// ascii implements Ascii, Ascii(length), Ascii(row, col, length) and
+// Ascii(row, col, rows, cols)
+func (s *session) ascii(args []string) ([]string, error) {
+	c, err := s.current()
+	if err != nil {
+		return nil, err
+	}
+	screen := c.Screen()
+	text := cellText(screen)
+	rows, cols := screen.Rows(), screen.Cols()
+	n := make([]int, len(args))
+	for i, arg := range args {
+		if n[i], err = strconv.Atoi(arg); err != nil || n[i] < 0 {
+			return nil, fmt.Errorf("invalid argument %q", arg)
+		}
+	}
+	switch len(args) {
+	case 0:
+		var data []string
+		for r := 0; r < rows; r++ {
+			data = append(data, strings.Join(text[r*cols:(r+1)*cols], ""))
+		}
+		return data, nil
+	case 1, 3:
+		addr, length := screen.Cursor(), n[0]
+		if len(args) == 3 {
+			addr, length = n[0]*cols+n[1], n[2]
+		}
+		if addr+length > len(text) {
+			return nil, errors.New("invalid argument")
+		}
+		return []string{strings.Join(text[addr:addr+length], "")}, nil
+	case 4:
+		if n[0]+n[2] > rows || n[1]+n[3] > cols {
+			return nil, errors.New("invalid argument")
+		}
+		var data []string
+		for r := n[0]; r < n[0]+n[2]; r++ {
+			data = append(data, strings.Join(text[r*cols+n[1]:r*cols+n[1]+n[3]], ""))
+		}
+		return data, nil
+	}
+	return nil, errors.New("Ascii takes 0, 1, 3 or 4 arguments")
+}
+
+// readBuffer implements ReadBuffer(Ascii): the hexadecimal code point of
+// each position, SF(c0=xx) for field attributes
+func (s *session) readBuffer(args []string) ([]string, error) {
+	c, err := s.current()
+	if err != nil {
+		return nil, err
+	}
+	if len(args) > 1 || (len(args) == 1 && !strings.EqualFold(args[0], "ascii")) {
+		return nil, errors.New("ReadBuffer only supports Ascii")
+	}
+	screen := c.Screen()
+	cells := screen.Cells()
+	cols := screen.Cols()
+	var data []string
+	for r := 0; r < screen.Rows(); r++ {
+		items := make([]string, cols)
+		for i, cell := range cells[r*cols : (r+1)*cols] {
+			if cell.FieldStart {
+				items[i] = fmt.Sprintf("SF(c0=%02x)", cell.Attr)
+			} else {
+				items[i] = fmt.Sprintf("%02x", cell.Char)
+			}
+		}
+		data = append(data, strings.Join(items, " "))
+	}
+	return data, nil
+}
+
+// status returns the s3270 status line: keyboard state, formatting, field
+// protection, connection, mode, model, rows, columns, cursor row and
+// column, window id and execution time
+func (s *session) status(elapsed time.Duration) string {
+	keyboard, formatting, protection, conn, mode := "U", "U", "U", "N", "N"
+	rows, cols, row, col := 24, 80, 0, 0
+	s.mu.Lock()
+	c, host := s.client, s.host
+	s.mu.Unlock()
+	if c != nil {
+		screen := c.Screen()
+		if screen.KeyboardLocked() {
+			keyboard = "L"
+		}
+		if screen.Formatted() {
+			formatting = "F"
+		}
+		if screen.Protected(screen.Cursor()) {
+			protection = "P"
+		}
+		conn, mode = "C("+host+")", "I"
+		rows, cols = screen.Rows(), screen.Cols()
+		row, col = screen.Cursor()/cols, screen.Cursor()%cols
+	}
+	return fmt.Sprintf("%s %s %s %s %s 2 %d %d %d %d 0x0 %.3f",
+		keyboard, formatting, protection, conn, mode, rows, cols, row, col, elapsed.Seconds())
+}
@@ -0,0 +1,219 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// CodePage converts text between an EBCDIC code page and UTF-8.
+//
+// Mixed code pages also have double byte characters, sent between Shift-Out
+// (SO) and Shift-In (SI) or in fields with the DBCS character set.
+type CodePage struct {
+	Name       string
+	decode     [256]rune
+	encode     map[rune]byte
+	dbcs       map[uint16]rune
+	dbcsEncode map[rune]uint16
+}
+
+const (
+	// SO starts double byte characters in mixed text
+	SO = 0x0e
+	// SI ends double byte characters in mixed text
+	SI = 0x0f
+)
+
+// NewCodePage builds a code page from a table holding the Unicode character
+// of each of the 256 EBCDIC bytes
+func NewCodePage(name string, table string) *CodePage {
+	cp := &CodePage{Name: name, encode: make(map[rune]byte)}
+	i := 0
+	for _, r := range table {
+		if i == 256 {
+			break
+		}
+		cp.decode[i] = r
+		if _, ok := cp.encode[r]; !ok && r != utf8.RuneError {
+			cp.encode[r] = byte(i)
+		}
+		i++
+	}
+	return cp
+}
+
+// NewDBCSCodePage builds a mixed code page. Single bytes are described as
+// for NewCodePage, double bytes by a table giving for each lead byte the
+// characters of the trail bytes 0x40 to 0xfe.
+func NewDBCSCodePage(name string, table string, dbcs map[byte]string) *CodePage {
+	cp := NewCodePage(name, table)
+	cp.dbcs = make(map[uint16]rune)
+	cp.dbcsEncode = make(map[rune]uint16)
+	for lead, row := range dbcs {
+		trail := 0x40
+		for _, r := range row {
+			if r != utf8.RuneError {
+				code := uint16(lead)<<8 | uint16(trail)
+				cp.dbcs[code] = r
+				if _, ok := cp.dbcsEncode[r]; !ok {
+					cp.dbcsEncode[r] = code
+				}
+			}
+			trail++
+		}
+	}
+	return cp
+}
+
+// IsDBCS reports whether the code page has double byte characters
+func (cp *CodePage) IsDBCS() bool {
+	return cp.dbcs != nil
+}
+
+// DecodeDBCS returns the character of a double byte, or U+FFFD
+func (cp *CodePage) DecodeDBCS(b1, b2 byte) rune {
+	if b1 == 0x40 && b2 == 0x40 {
+		// Double byte space
+		return '\u3000'
+	}
+	if r, ok := cp.dbcs[uint16(b1)<<8|uint16(b2)]; ok {
+		return r
+	}
+	return utf8.RuneError
+}
+
+// DecodeByte returns the character of an EBCDIC byte
+func (cp *CodePage) DecodeByte(b byte) rune {
+	return cp.decode[b]
+}
+
+// EncodeRune returns the EBCDIC byte of a character, ok is false if the code
+// page does not have it
+func (cp *CodePage) EncodeRune(r rune) (b byte, ok bool) {
+	b, ok = cp.encode[r]
+	return
+}
+
+// Decode converts EBCDIC text to UTF-8. With mixed code pages, bytes between
+// SO and SI are decoded as double bytes.
+func (cp *CodePage) Decode(src []byte) string {
+	var sb strings.Builder
+	sb.Grow(len(src))
+	shifted := false
+	for i := 0; i < len(src); i++ {
+		b := src[i]
+		switch {
+		case cp.IsDBCS() && b == SO:
+			shifted = true
+		case cp.IsDBCS() && b == SI:
+			shifted = false
+		case shifted && i+1 < len(src):
+			sb.WriteRune(cp.DecodeDBCS(b, src[i+1]))
+			i++
+		default:
+			sb.WriteRune(cp.decode[b])
+		}
+	}
+	return sb.String()
+}
+
+// decodeDBCS converts text made of double bytes only, as found in double
+// byte fields, to UTF-8
+func (cp *CodePage) decodeDBCS(src []byte) string {
+	var sb strings.Builder
+	for i := 0; i+1 < len(src); i += 2 {
+		sb.WriteRune(cp.DecodeDBCS(src[i], src[i+1]))
+	}
+	return sb.String()
+}
+
+// Encode converts UTF-8 text to EBCDIC, characters missing from the code
+// page are replaced by '?'. With mixed code pages, double byte characters
+// are enclosed in SO and SI.
+func (cp *CodePage) Encode(s string) []byte {
+	res := make([]byte, 0, utf8.RuneCountInString(s))
+	shifted := false
+	for _, r := range s {
+		if code, ok := cp.dbcsEncode[r]; ok {
+			if _, single := cp.encode[r]; !single {
+				if !shifted {
+					res = append(res, SO)
+					shifted = true
+				}
+				res = append(res, byte(code>>8), byte(code))
+				continue
+			}
+		}
+		if shifted {
+			res = append(res, SI)
+			shifted = false
+		}
+		b, ok := cp.encode[r]
+		if !ok {
+			b = cp.encode['?']
+		}
+		res = append(res, b)
+	}
+	if shifted {
+		res = append(res, SI)
+	}
+	return res
+}
+
+var (
+	codePagesMu sync.RWMutex
+	codePages   = make(map[string]*CodePage)
+)
+
+// RegisterCodePage makes a code page available to LookupCodePage
+func RegisterCodePage(cp *CodePage) {
+	codePagesMu.Lock()
+	defer codePagesMu.Unlock()
+	codePages[codePageKey(cp.Name)] = cp
+}
+
+// LookupCodePage returns the registered code page with the given name, or
+// nil. Names are case insensitive and "CP037", "IBM-037", "IBM037" and "037"
+// all designate the same code page.
+func LookupCodePage(name string) *CodePage {
+	codePagesMu.RLock()
+	defer codePagesMu.RUnlock()
+	return codePages[codePageKey(name)]
+}
+
+func codePageKey(name string) string {
+	key := strings.ToUpper(name)
+	for _, prefix := range []string{"CP", "IBM-", "IBM"} {
+		if strings.HasPrefix(key, prefix) {
+			key = key[len(prefix):]
+			break
+		}
+	}
+	return strings.TrimLeft(key, "0")
+}
+
+// DefaultCodePage is the code page used when none is configured
+var DefaultCodePage = CP037
+
+// codePageOrDefault returns cp, or the default code page if cp is nil
+func codePageOrDefault(cp *CodePage) *CodePage {
+	if cp == nil {
+		return DefaultCodePage
+	}
+	return cp
+}
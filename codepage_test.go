@@ -0,0 +1,49 @@
+package tn3270_test
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+var _ = Describe("Code pages", func() {
+	It("Should look code pages up by name", func() {
+		Expect(tn3270.LookupCodePage("cp037")).To(BeIdenticalTo(tn3270.CP037))
+		Expect(tn3270.LookupCodePage("IBM-1047")).To(BeIdenticalTo(tn3270.CP1047))
+		Expect(tn3270.LookupCodePage("1141")).To(BeIdenticalTo(tn3270.CP1141))
+		Expect(tn3270.LookupCodePage("CP9999")).To(BeNil())
+	})
+
+	It("Should decode national characters to UTF-8", func() {
+		Expect(tn3270.CP273.Decode([]byte{0xc7, 0xd9, 0x5a, 0xa1, 0xc5})).To(Equal("GRÜßE"))
+		Expect(tn3270.CP297.Decode([]byte{0xc0})).To(Equal("é"))
+		Expect(tn3270.CP285.Decode([]byte{0x5b})).To(Equal("£"))
+		Expect(tn3270.CP1140.Decode([]byte{0x9f})).To(Equal("€"))
+		Expect(tn3270.CP1141.Decode([]byte{0x9f})).To(Equal("€"))
+	})
+
+	It("Should encode UTF-8 text", func() {
+		for _, cp := range []*tn3270.CodePage{tn3270.CP037, tn3270.CP500, tn3270.CP1047, tn3270.CP1148} {
+			Expect(cp.Decode(cp.Encode("Hello, [World]!"))).To(Equal("Hello, [World]!"))
+		}
+		Expect(tn3270.CP037.Encode("€")).To(Equal(tn3270.CP037.Encode("?")))
+	})
+
+	It("Should be used by client and server", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(Succeed())
+		server := &tn3270.Server{Handler: &MyHandler{}, CodePage: tn3270.CP1141}
+		go server.Serve(listener)
+		defer server.Close()
+
+		client := tn3270.NewClient("09123456")
+		client.CodePage = tn3270.CP1141
+		recv, err := client.Connect(listener.Addr().String())
+		Expect(err).To(Succeed())
+		<-recv
+		Expect(client.SendRecv("Grüße 5€")).To(Equal("ECHO: Grüße 5€"))
+	})
+})
@@ -0,0 +1,452 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+// Code page tables, giving the Unicode character of each EBCDIC byte. They
+// were generated from the IBM tables shipped with iconv.
+
+// CP037 (USA, Canada)
+var cp037Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1\u00a2.<(+|" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df!$*);\u00ac" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00a6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:#@'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u00a4" + // 0x90
+	"\u00b5~stuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"^\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be[]\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"{ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"}JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP273 (Germany, Austria)
+var cp273Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2{\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1\u00c4.<(+!" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec~\u00dc$*);^" + // 0x50
+	"-/\u00c2[\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00f6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:#\u00a7'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u00a4" + // 0x90
+	"\u00b5\u00dfstuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9@\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"\u00e4ABCDEFGHI\u00ad\u00f4\u00a6\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00fcJKLMNOPQR\u00b9\u00fb}\u00f9\u00fa\u00ff" + // 0xd0
+	"\u00d6\u00f7STUVWXYZ\u00b2\u00d4\\\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db]\u00d9\u00da\u009f" // 0xf0
+
+// CP277 (Denmark, Norway)
+var cp277Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3}\u00e7\u00f1#.<(+!" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df\u00a4\u00c5*);^" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3$\u00c7\u00d1\u00f8,%_>?" + // 0x60
+	"\u00a6\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:\u00c6\u00d8'=\"" + // 0x70
+	"@abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba{\u00b8[]" + // 0x90
+	"\u00b5\u00fcstuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"\u00e6ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00e5JKLMNOPQR\u00b9\u00fb~\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP278 (Finland, Sweden)
+var cp278Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2{\u00e0\u00e1\u00e3}\u00e7\u00f1\u00a7.<(+!" + // 0x40
+	"&`\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df\u00a4\u00c5*);^" + // 0x50
+	"-/\u00c2#\u00c0\u00c1\u00c3$\u00c7\u00d1\u00f6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc\u00e9:\u00c4\u00d6'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6]" + // 0x90
+	"\u00b5\u00fcstuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9[\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"\u00e4ABCDEFGHI\u00ad\u00f4\u00a6\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00e5JKLMNOPQR\u00b9\u00fb~\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4@\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP280 (Italy)
+var cp280Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4{\u00e1\u00e3\u00e5\\\u00f1\u00b0.<(+!" + // 0x40
+	"&]\u00ea\u00eb}\u00ed\u00ee\u00ef~\u00df\u00e9$*);^" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00f2,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc\u00f9:\u00a3\u00a7'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"[jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u00a4" + // 0x90
+	"\u00b5\u00ecstuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2#\u00a5\u00b7\u00a9@\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"\u00e0ABCDEFGHI\u00ad\u00f4\u00f6\u00a6\u00f3\u00f5" + // 0xc0
+	"\u00e8JKLMNOPQR\u00b9\u00fb\u00fc`\u00fa\u00ff" + // 0xd0
+	"\u00e7\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP284 (Spain, Latin America)
+var cp284Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00a6[.<(+|" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df]$*);\u00ac" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7#\u00f1,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:\u00d1@'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u00a4" + // 0x90
+	"\u00b5\u00a8stuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be^!\u00af~\u00b4\u00d7" + // 0xb0
+	"{ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"}JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP285 (United Kingdom)
+var cp285Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1$.<(+|" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df!\u00a3*);\u00ac" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00a6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:#@'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u00a4" + // 0x90
+	"\u00b5\u203estuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2[\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be^]~\u00a8\u00b4\u00d7" + // 0xb0
+	"{ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"}JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP297 (France)
+var cp297Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4@\u00e1\u00e3\u00e5\\\u00f1\u00b0.<(+!" + // 0x40
+	"&{\u00ea\u00eb}\u00ed\u00ee\u00ef\u00ec\u00df\u00a7$*);^" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00f9,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc\u00b5:\u00a3\u00e0'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"[jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u00a4" + // 0x90
+	"`\u00a8stuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2#\u00a5\u00b7\u00a9]\u00b6\u00bc\u00bd\u00be\u00ac|\u00af~\u00b4\u00d7" + // 0xb0
+	"\u00e9ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00e8JKLMNOPQR\u00b9\u00fb\u00fc\u00a6\u00fa\u00ff" + // 0xd0
+	"\u00e7\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP500 (International)
+var cp500Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1[.<(+!" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df]$*);^" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00a6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:#@'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u00a4" + // 0x90
+	"\u00b5~stuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"{ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"}JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP871 (Iceland)
+var cp871Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1\u00fe.<(+!" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df\u00c6$*);\u00d6" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00a6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc\u00f0:#\u00d0'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb`\u00fd{\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba}\u00b8]\u00a4" + // 0x90
+	"\u00b5\u00f6stuvwxyz\u00a1\u00bf@\u00dd[\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\\\u00d7" + // 0xb0
+	"\u00deABCDEFGHI\u00ad\u00f4~\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00e6JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\u00b4\u00f7STUVWXYZ\u00b2\u00d4^\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1047 (Latin-1 open systems)
+var cp1047Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1\u00a2.<(+|" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df!$*);^" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00a6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:#@'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u00a4" + // 0x90
+	"\u00b5~stuvwxyz\u00a1\u00bf\u00d0[\u00de\u00ae" + // 0xa0
+	"\u00ac\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be\u00dd\u00a8\u00af]\u00b4\u00d7" + // 0xb0
+	"{ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"}JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1140 is CP037 with the euro sign
+var cp1140Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1\u00a2.<(+|" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df!$*);\u00ac" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00a6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:#@'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u20ac" + // 0x90
+	"\u00b5~stuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"^\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be[]\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"{ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"}JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1141 is CP273 with the euro sign
+var cp1141Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2{\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1\u00c4.<(+!" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec~\u00dc$*);^" + // 0x50
+	"-/\u00c2[\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00f6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:#\u00a7'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u20ac" + // 0x90
+	"\u00b5\u00dfstuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9@\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"\u00e4ABCDEFGHI\u00ad\u00f4\u00a6\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00fcJKLMNOPQR\u00b9\u00fb}\u00f9\u00fa\u00ff" + // 0xd0
+	"\u00d6\u00f7STUVWXYZ\u00b2\u00d4\\\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db]\u00d9\u00da\u009f" // 0xf0
+
+// CP1142 is CP277 with the euro sign
+var cp1142Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3}\u00e7\u00f1#.<(+!" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df\u20ac\u00c5*);^" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3$\u00c7\u00d1\u00f8,%_>?" + // 0x60
+	"\u00a6\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:\u00c6\u00d8'=\"" + // 0x70
+	"@abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba{\u00b8[]" + // 0x90
+	"\u00b5\u00fcstuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"\u00e6ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00e5JKLMNOPQR\u00b9\u00fb~\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1143 is CP278 with the euro sign
+var cp1143Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2{\u00e0\u00e1\u00e3}\u00e7\u00f1\u00a7.<(+!" + // 0x40
+	"&`\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df\u20ac\u00c5*);^" + // 0x50
+	"-/\u00c2#\u00c0\u00c1\u00c3$\u00c7\u00d1\u00f6,%_>?" + // 0x60
+	"\u00f8\\\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc\u00e9:\u00c4\u00d6'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6]" + // 0x90
+	"\u00b5\u00fcstuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9[\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"\u00e4ABCDEFGHI\u00ad\u00f4\u00a6\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00e5JKLMNOPQR\u00b9\u00fb~\u00f9\u00fa\u00ff" + // 0xd0
+	"\u00c9\u00f7STUVWXYZ\u00b2\u00d4@\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1144 is CP280 with the euro sign
+var cp1144Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4{\u00e1\u00e3\u00e5\\\u00f1\u00b0.<(+!" + // 0x40
+	"&]\u00ea\u00eb}\u00ed\u00ee\u00ef~\u00df\u00e9$*);^" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00f2,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc\u00f9:\u00a3\u00a7'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"[jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u20ac" + // 0x90
+	"\u00b5\u00ecstuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2#\u00a5\u00b7\u00a9@\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"\u00e0ABCDEFGHI\u00ad\u00f4\u00f6\u00a6\u00f3\u00f5" + // 0xc0
+	"\u00e8JKLMNOPQR\u00b9\u00fb\u00fc`\u00fa\u00ff" + // 0xd0
+	"\u00e7\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1145 is CP284 with the euro sign
+var cp1145Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00a6[.<(+|" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df]$*);\u00ac" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7#\u00f1,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:\u00d1@'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u20ac" + // 0x90
+	"\u00b5\u00a8stuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be^!\u00af~\u00b4\u00d7" + // 0xb0
+	"{ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"}JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1146 is CP285 with the euro sign
+var cp1146Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1$.<(+|" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df!\u00a3*);\u00ac" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00a6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:#@'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u20ac" + // 0x90
+	"\u00b5\u00afstuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2[\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be^]~\u00a8\u00b4\u00d7" + // 0xb0
+	"{ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"}JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1147 is CP297 with the euro sign
+var cp1147Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4@\u00e1\u00e3\u00e5\\\u00f1\u00b0.<(+!" + // 0x40
+	"&{\u00ea\u00eb}\u00ed\u00ee\u00ef\u00ec\u00df\u00a7$*);^" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00f9,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc\u00b5:\u00a3\u00e0'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"[jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u20ac" + // 0x90
+	"`\u00a8stuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2#\u00a5\u00b7\u00a9]\u00b6\u00bc\u00bd\u00be\u00ac|\u00af~\u00b4\u00d7" + // 0xb0
+	"\u00e9ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00e8JKLMNOPQR\u00b9\u00fb\u00fc\u00a6\u00fa\u00ff" + // 0xd0
+	"\u00e7\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1148 is CP500 with the euro sign
+var cp1148Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1[.<(+!" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df]$*);^" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00a6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc`:#@'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb\u00f0\u00fd\u00fe\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba\u00e6\u00b8\u00c6\u20ac" + // 0x90
+	"\u00b5~stuvwxyz\u00a1\u00bf\u00d0\u00dd\u00de\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\u00b4\u00d7" + // 0xb0
+	"{ABCDEFGHI\u00ad\u00f4\u00f6\u00f2\u00f3\u00f5" + // 0xc0
+	"}JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\\\u00f7STUVWXYZ\u00b2\u00d4\u00d6\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// CP1149 is CP871 with the euro sign
+var cp1149Table = "" +
+	"\u0000\u0001\u0002\u0003\u009c\u0009\u0086\u007f\u0097\u008d\u008e\u000b\u000c\u000d\u000e\u000f" + // 0x00
+	"\u0010\u0011\u0012\u0013\u009d\u0085\u0008\u0087\u0018\u0019\u0092\u008f\u001c\u001d\u001e\u001f" + // 0x10
+	"\u0080\u0081\u0082\u0083\u0084\u000a\u0017\u001b\u0088\u0089\u008a\u008b\u008c\u0005\u0006\u0007" + // 0x20
+	"\u0090\u0091\u0016\u0093\u0094\u0095\u0096\u0004\u0098\u0099\u009a\u009b\u0014\u0015\u009e\u001a" + // 0x30
+	" \u00a0\u00e2\u00e4\u00e0\u00e1\u00e3\u00e5\u00e7\u00f1\u00de.<(+!" + // 0x40
+	"&\u00e9\u00ea\u00eb\u00e8\u00ed\u00ee\u00ef\u00ec\u00df\u00c6$*);\u00d6" + // 0x50
+	"-/\u00c2\u00c4\u00c0\u00c1\u00c3\u00c5\u00c7\u00d1\u00a6,%_>?" + // 0x60
+	"\u00f8\u00c9\u00ca\u00cb\u00c8\u00cd\u00ce\u00cf\u00cc\u00f0:#\u00d0'=\"" + // 0x70
+	"\u00d8abcdefghi\u00ab\u00bb`\u00fd{\u00b1" + // 0x80
+	"\u00b0jklmnopqr\u00aa\u00ba}\u00b8]\u20ac" + // 0x90
+	"\u00b5\u00f6stuvwxyz\u00a1\u00bf@\u00dd[\u00ae" + // 0xa0
+	"\u00a2\u00a3\u00a5\u00b7\u00a9\u00a7\u00b6\u00bc\u00bd\u00be\u00ac|\u00af\u00a8\\\u00d7" + // 0xb0
+	"\u00feABCDEFGHI\u00ad\u00f4~\u00f2\u00f3\u00f5" + // 0xc0
+	"\u00e6JKLMNOPQR\u00b9\u00fb\u00fc\u00f9\u00fa\u00ff" + // 0xd0
+	"\u00b4\u00f7STUVWXYZ\u00b2\u00d4^\u00d2\u00d3\u00d5" + // 0xe0
+	"0123456789\u00b3\u00db\u00dc\u00d9\u00da\u009f" // 0xf0
+
+// Code pages registered by default
+var (
+	CP037  = NewCodePage("CP037", cp037Table)
+	CP273  = NewCodePage("CP273", cp273Table)
+	CP277  = NewCodePage("CP277", cp277Table)
+	CP278  = NewCodePage("CP278", cp278Table)
+	CP280  = NewCodePage("CP280", cp280Table)
+	CP284  = NewCodePage("CP284", cp284Table)
+	CP285  = NewCodePage("CP285", cp285Table)
+	CP297  = NewCodePage("CP297", cp297Table)
+	CP500  = NewCodePage("CP500", cp500Table)
+	CP871  = NewCodePage("CP871", cp871Table)
+	CP1047 = NewCodePage("CP1047", cp1047Table)
+	CP1140 = NewCodePage("CP1140", cp1140Table)
+	CP1141 = NewCodePage("CP1141", cp1141Table)
+	CP1142 = NewCodePage("CP1142", cp1142Table)
+	CP1143 = NewCodePage("CP1143", cp1143Table)
+	CP1144 = NewCodePage("CP1144", cp1144Table)
+	CP1145 = NewCodePage("CP1145", cp1145Table)
+	CP1146 = NewCodePage("CP1146", cp1146Table)
+	CP1147 = NewCodePage("CP1147", cp1147Table)
+	CP1148 = NewCodePage("CP1148", cp1148Table)
+	CP1149 = NewCodePage("CP1149", cp1149Table)
+)
+
+func init() {
+	for _, cp := range []*CodePage{
+		CP037, CP273, CP277, CP278, CP280, CP284, CP285, CP297, CP500, CP871,
+		CP1047, CP1140, CP1141, CP1142, CP1143, CP1144, CP1145, CP1146, CP1147,
+		CP1148, CP1149,
+	} {
+		RegisterCodePage(cp)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import "bytes"
+
+// addrCodes is the 6-bit to EBCDIC table used by 12-bit buffer addresses
+var addrCodes = [64]byte{
+	0x40, 0xc1, 0xc2, 0xc3, 0xc4, 0xc5, 0xc6, 0xc7, 0xc8, 0xc9, 0x4a, 0x4b, 0x4c, 0x4d, 0x4e, 0x4f,
+	0x50, 0xd1, 0xd2, 0xd3, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8, 0xd9, 0x5a, 0x5b, 0x5c, 0x5d, 0x5e, 0x5f,
+	0x60, 0x61, 0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe7, 0xe8, 0xe9, 0x6a, 0x6b, 0x6c, 0x6d, 0x6e, 0x6f,
+	0xf0, 0xf1, 0xf2, 0xf3, 0xf4, 0xf5, 0xf6, 0xf7, 0xf8, 0xf9, 0x7a, 0x7b, 0x7c, 0x7d, 0x7e, 0x7f,
+}
+
+// decodeAddr decodes a 12 or 14-bit buffer address
+func decodeAddr(b0, b1 byte) int {
+	if (b0 & 0xc0) == 0x00 {
+		return (int(b0&0x3f) << 8) | int(b1)
+	}
+	return (int(b0&0x3f) << 6) | int(b1&0x3f)
+}
+
+// encodeAddr encodes a buffer address, using 12-bit addressing when possible
+func encodeAddr(addr int) []byte {
+	if addr < 4096 {
+		return []byte{addrCodes[(addr>>6)&0x3f], addrCodes[addr&0x3f]}
+	}
+	return []byte{byte(addr>>8) & 0x3f, byte(addr)}
+}
+
+// text reports text found by the grammar. Set Attribute orders are not
+// described by the grammar, they are taken out of the text here.
+func (parser *parser) text(data []byte) {
+	for {
+		i := bytes.IndexByte(data, 0x28)
+		if i == -1 || i+3 > len(data) {
+			break
+		}
+		if i > 0 {
+			parser.tn3270h.OnTN3270Text(data[:i])
+		}
+		if h, ok := parser.tn3270h.(TN3270AttributeHandler); ok {
+			h.OnTN3270SA(data[i+1], data[i+2])
+		}
+		data = data[i+3:]
+	}
+	if len(data) > 0 {
+		parser.tn3270h.OnTN3270Text(data)
+	}
+}
+
+// attribute reports a type/value pair of a Start Field Extended order
+func (parser *parser) attribute(t, v byte) {
+	if h, ok := parser.tn3270h.(TN3270AttributeHandler); ok {
+		h.OnTN3270FieldAttribute(t, v)
+	}
+}
+
+// parseInbound decodes an inbound (terminal to host) record. Inbound data
+// streams are decoded here rather than by the Ragel grammar, which only knows
+// about the ENTER AID: most AID values overlap with outbound command codes.
+func parseInbound(data []byte, h TN3270Handler) {
+	if len(data) == 0 {
+		return
+	}
+	ah, _ := h.(TN3270AttributeHandler)
+	h.OnTN3270AID(data[0])
+	data = data[1:]
+	if len(data) >= 2 {
+		// Skip the cursor address
+		data = data[2:]
+	}
+	start := 0
+	text := func(end int) {
+		if start < end {
+			h.OnTN3270Text(data[start:end])
+		}
+	}
+	i := 0
+	for i < len(data) {
+		switch data[i] {
+		case 0x11: // SBA
+			if i+3 > len(data) {
+				i = len(data)
+				break
+			}
+			text(i)
+			h.OnTN3270SBA(decodeAddr(data[i+1], data[i+2]))
+			i += 3
+			start = i
+			continue
+		case 0x1d: // SF
+			if i+2 > len(data) {
+				i = len(data)
+				break
+			}
+			text(i)
+			h.OnTN3270SF(data[i+1])
+			i += 2
+			start = i
+			continue
+		case 0x29: // SFE
+			if i+2 > len(data) {
+				i = len(data)
+				break
+			}
+			text(i)
+			h.OnTN3270SFE(data[i+1])
+			n := int(data[i+1])
+			i += 2
+			for ; n > 0 && i+2 <= len(data); n-- {
+				if ah != nil {
+					ah.OnTN3270FieldAttribute(data[i], data[i+1])
+				}
+				i += 2
+			}
+			start = i
+			continue
+		case 0x28: // SA
+			text(i)
+			if ah != nil && i+3 <= len(data) {
+				ah.OnTN3270SA(data[i+1], data[i+2])
+			}
+			i += 3
+			start = i
+			continue
+		}
+		i++
+	}
+	if start < len(data) {
+		text(len(data))
+	}
+	h.OnTN3270Message()
+}
@@ -0,0 +1,46 @@
+package tn3270_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+// nopHandler ignores telnet commands, subnegotiations and TN3270E
+// negotiation
+type nopHandler struct{}
+
+func (nopHandler) OnTNCommand(byte)                                 {}
+func (nopHandler) OnTNArgCommand(byte, byte)                        {}
+func (nopHandler) OnTNSubnegotiation(byte, []byte) bool             { return true }
+func (nopHandler) OnTN3270DeviceTypeRequest([]byte, []byte, []byte) {}
+func (nopHandler) OnTN3270DeviceTypeIs([]byte, []byte)              {}
+func (nopHandler) OnTN3270DeviceTypeReject(byte)                    {}
+func (nopHandler) OnTN3270FunctionsIs([]byte)                       {}
+func (nopHandler) OnTN3270FunctionsRequest([]byte)                  {}
+func (nopHandler) OnTN3270SendDeviceType()                          {}
+
+var _ = Describe("DBCS", func() {
+	It("Should decode and encode mixed text", func() {
+		Expect(tn3270.CP930.Decode([]byte{0xc1, 0x0e, 0x45, 0x41, 0x0f, 0xc2})).To(Equal("A一B"))
+		Expect(tn3270.CP930.Encode("AB一")).To(Equal([]byte{0xc1, 0xc2, 0x0e, 0x45, 0x41, 0x0f}))
+		Expect(tn3270.CP937.Decode(tn3270.CP937.Encode("TEST 中文"))).To(Equal("TEST 中文"))
+		Expect(tn3270.CP935.Decode(tn3270.CP935.Encode("TEST 中文"))).To(Equal("TEST 中文"))
+		Expect(tn3270.LookupCodePage("IBM-939")).To(BeIdenticalTo(tn3270.CP939))
+	})
+
+	It("Should display SO/SI text and DBCS fields on the virtual screen", func() {
+		screen := tn3270.NewVirtualScreenTN3270Handler(24, 80)
+		screen.CodePage = tn3270.CP930
+		p := tn3270.NewParser(nopHandler{}, nopHandler{}, screen, &tn3270.VerboseErrorHandler{})
+		Expect(p.Parse([]byte{
+			0x00, 0x00, 0x00, 0x00, 0x00, 0xf5, 0xc3, 0x11, 0xc1, 0x50,
+			0xc1, 0xc2, 0xc3, 0x0e, 0x45, 0x41, 0x45, 0x42, 0x0f, // ABC SO 一二 SI
+			0x29, 0x01, 0x43, 0xf8, 0x45, 0x43, 0x45, 0x44, // SFE DBCS 三四
+			0x1d, 0x60, 0x28, 0x43, 0xf8, 0x45, 0x45, 0x28, 0x00, 0x00, 0xc1, // SF SA DBCS 五 SA reset A
+			0xff, 0xef,
+		})).To(Succeed())
+		Expect(screen.String()).To(Equal("ABC 一二  三四 五A"))
+	})
+})
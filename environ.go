@@ -0,0 +1,215 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import "sort"
+
+// Commands of the TERMINAL-TYPE (RFC 1091) and NEW-ENVIRON (RFC 1572)
+// subnegotiations
+const (
+	subnegIS   = 0x00
+	subnegSEND = 0x01
+	subnegINFO = 0x02
+)
+
+// Types of the NEW-ENVIRON variables
+const (
+	envVAR     = 0x00
+	envVALUE   = 0x01
+	envESC     = 0x02
+	envUSERVAR = 0x03
+)
+
+// wellKnownVars are the variables defined by RFC 1572, the other ones are
+// sent as user variables (DEVNAME, IBMRSEED, ...)
+var wellKnownVars = map[string]bool{
+	"USER":       true,
+	"JOB":        true,
+	"ACCT":       true,
+	"PRINTER":    true,
+	"SYSTEMTYPE": true,
+	"DISPLAY":    true,
+}
+
+// envVar is a variable of a NEW-ENVIRON subnegotiation. The name is empty
+// when a SEND asks for all the variables of the type.
+type envVar struct {
+	user     bool // USERVAR rather than VAR
+	name     string
+	value    string
+	hasValue bool // undefined variables are sent without VALUE
+}
+
+// parseEnviron decodes the variables following the command of a
+// NEW-ENVIRON subnegotiation
+func parseEnviron(data []byte) []envVar {
+	var vars []envVar
+	var v *envVar
+	var field []byte
+	value := false
+	flush := func() {
+		if v == nil {
+			return
+		}
+		if value {
+			v.value = string(field)
+		} else {
+			v.name = string(field)
+		}
+		field = field[:0]
+	}
+	for i := 0; i < len(data); i++ {
+		switch b := data[i]; b {
+		case envVAR, envUSERVAR:
+			flush()
+			vars = append(vars, envVar{user: b == envUSERVAR})
+			v = &vars[len(vars)-1]
+			value = false
+		case envVALUE:
+			flush()
+			if v != nil {
+				v.hasValue = true
+			}
+			value = true
+		case envESC:
+			if i+1 < len(data) {
+				i++
+				field = append(field, data[i])
+			}
+		default:
+			field = append(field, b)
+		}
+	}
+	flush()
+	return vars
+}
+
+// escapeEnviron escapes the bytes of a name or value that are types
+func escapeEnviron(s string) []byte {
+	out := make([]byte, 0, len(s))
+	for _, b := range []byte(s) {
+		if b <= envUSERVAR {
+			out = append(out, envESC)
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+// encodeEnviron encodes a NEW-ENVIRON subnegotiation, IAC not included
+func encodeEnviron(cmd byte, vars []envVar) []byte {
+	data := []byte{cmd}
+	for _, v := range vars {
+		if v.user {
+			data = append(data, envUSERVAR)
+		} else {
+			data = append(data, envVAR)
+		}
+		data = append(data, escapeEnviron(v.name)...)
+		if v.hasValue {
+			data = append(data, envVALUE)
+			data = append(data, escapeEnviron(v.value)...)
+		}
+	}
+	return data
+}
+
+// environSend encodes the SEND of the named variables, all the variables
+// are requested if there is none
+func environSend(names []string) []byte {
+	vars := make([]envVar, len(names))
+	for i, name := range names {
+		vars[i] = envVar{user: !wellKnownVars[name], name: name}
+	}
+	return encodeEnviron(subnegSEND, vars)
+}
+
+// environAnswer answers a SEND with the variables of env. The variables
+// requested but not defined are sent without value.
+func environAnswer(request []envVar, env map[string]string) []byte {
+	var vars []envVar
+	all := len(request) == 0
+	for _, r := range request {
+		if r.name == "" {
+			all = true
+			continue
+		}
+		value, ok := env[r.name]
+		vars = append(vars, envVar{user: r.user, name: r.name, value: value, hasValue: ok})
+	}
+	if all {
+		for _, name := range sortedKeys(env) {
+			if requested(request, name) {
+				continue
+			}
+			user := !wellKnownVars[name]
+			if len(request) > 0 && !typeRequested(request, user) {
+				continue
+			}
+			vars = append(vars, envVar{user: user, name: name, value: env[name], hasValue: true})
+		}
+	}
+	return encodeEnviron(subnegIS, vars)
+}
+
+func sortedKeys(env map[string]string) []string {
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func requested(request []envVar, name string) bool {
+	for _, r := range request {
+		if r.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// typeRequested reports whether a SEND asks for all the variables of a type
+func typeRequested(request []envVar, user bool) bool {
+	for _, r := range request {
+		if r.name == "" && r.user == user {
+			return true
+		}
+	}
+	return false
+}
+
+// terminalTypeHandler answers the TERMINAL-TYPE SEND subnegotiations with
+// the device type
+func terminalTypeHandler(deviceType func() string) OptionHandler {
+	return OptionHandler{Local: true, OnSubnegotiation: func(data []byte) []byte {
+		if len(data) == 0 || data[0] != subnegSEND {
+			return nil
+		}
+		return append([]byte{subnegIS}, deviceType()...)
+	}}
+}
+
+// environHandler answers the NEW-ENVIRON SEND subnegotiations with the
+// variables returned by env
+func environHandler(env func() map[string]string) OptionHandler {
+	return OptionHandler{Local: true, OnSubnegotiation: func(data []byte) []byte {
+		if len(data) == 0 || data[0] != subnegSEND {
+			return nil
+		}
+		return environAnswer(parseEnviron(data[1:]), env())
+	}}
+}
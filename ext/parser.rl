@@ -55,14 +55,14 @@ func (parser *parser) StartTxt() {
 
 func (parser *parser) EndTxt() {
     if (parser.state.starttxt != -1 && parser.state.starttxt < parser.state.position) {
-        parser.tn3270h.OnTN3270Text(parser.state.data[parser.state.starttxt:parser.state.position])
+        parser.text(parser.state.data[parser.state.starttxt:parser.state.position])
     }
     parser.state.starttxt = -1
 }
 
 func (parser *parser) CaptureTxt() {
     if (parser.state.starttxt != -1 && parser.state.starttxt < parser.state.position) {
-        parser.tn3270h.OnTN3270Text(parser.state.data[parser.state.starttxt:parser.state.position])
+        parser.text(parser.state.data[parser.state.starttxt:parser.state.position])
         parser.state.starttxt = 0
     }
 }
@@ -89,7 +89,7 @@ func (state *state) GetAddr() int {
 
     action tn3270_command { parser.tn3270h.OnTN3270Command(fc); }
     action tn3270_aid { parser.tn3270h.OnTN3270AID(fc); }
-    action tn3270_wcc { parser.tn3270h.OnTN3270WCC(fc); }
+    action tn3270_wcc { parser.tn3270h.OnTN3270WCC(fc); parser.state.starttxt = fpc + 1; }
     action tn3270_sba { parser.tn3270h.OnTN3270SBA(state.GetAddr()); }
     action tn3270_eua { parser.tn3270h.OnTN3270EUA(state.GetAddr()); }
     action tn3270_ic { parser.tn3270h.OnTN3270IC(); }
@@ -146,7 +146,7 @@ func (state *state) GetAddr() int {
     action tn3270_starttxt { parser.StartTxt(); }
     action tn3270_endtxt { parser.EndTxt(); }
 
-    action tn3270_endarg { state.count--; if(state.count == 0) { fret; } }
+    action tn3270_endarg { parser.attribute(state.data[fpc-1], fc); state.count--; if(state.count == 0) { fret; } }
 
     action tn_subneg { fcall tn3270_subneg; }
     action tn_subneg_end { fret; }
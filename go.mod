@@ -3,6 +3,7 @@ module github.com/wuzuf/go-tn3270
 go 1.12
 
 require (
+	github.com/gorilla/websocket v1.4.1
 	github.com/juju/errors v0.0.0-20190207033735-e65537c515d7
 	github.com/juju/loggo v0.0.0-20190212223446-d976af380377 // indirect
 	github.com/juju/testing v0.0.0-20190429233213-dfc56b8c09fc // indirect
@@ -10,5 +11,4 @@ require (
 	github.com/onsi/gomega v1.5.0
 	gopkg.in/h2non/gock.v1 v1.0.14 // indirect
 	gopkg.in/mgo.v2 v2.0.0-20180705113604-9856a29383ce // indirect
-	gopkg.in/tomb.v2 v2.0.0-20161208151619-d5d1b5820637
 )
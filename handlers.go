@@ -15,7 +15,6 @@
 package tn3270
 
 import (
-	"bytes"
 	"fmt"
 	"strings"
 	"errors"
@@ -25,6 +24,7 @@ var e2a string = " \x01\x02\x03\x9c\t\x86\x7f\x97\x8d\x8e\x0b\x0c\r\x0e\x0f\x10\
 var e2d string = " \x01\x02\x03\x9c\t\x86\x7f\x97\x8d\x8e\x0b\x0c\r\x0e\x0f\x10\x11\x12\x13\x9d\x85\x08\x87\x18\x19\x92\x8f\x1c \x1e\x1f\x80\x81\x82\x83\x84\n\x17\x1b\x88\x89\x8a\x8b\x8c\x05\x06\x07\x90\x91\x16\x93\x94\x95\x96\x04\x98\x99\x9a\x9b\x14\x15\x9e\x1a \xa0\xa1\xa2\xa3\xa4\xa5\xa6\xa7\xa8[.<(+!&\xa9\xaa\xab\xac\xad\xae\xaf\xb0\xb1]$*);^-/\xb2\xb3\xb4\xb5\xb6\xb7\xb8\xb9|,%_>?\xba\xbb\xbc\xbd\xbe\xbf\xc0\xc1\xc2`:#@'=\"\xc3abcdefghi\xc4\xc5\xc6\xc7\xc8\xc9\xcajklmnopqr\xcb\xcc\xcd\xce\xcf\xd0\xd1~stuvwxyz\xd2\xd3\xd4\xd5\xd6\xd7\xd8\xd9\xda\xdb\xdc\xdd\xde\xdf\xe0\xe1\xe2\xe3\xe4\xe5\xe6\xe7{ABCDEFGHI\xe8\xe9\xea\xeb\xec\xed}JKLMNOPQR\xee\xef\xf0\xf1\xf2\xf3\\\x9fSTUVWXYZ\xf4\xf5\xf6\xf7\xf8\xf90123456789\xfa\xfb\xfc\xfd\xfe\xff"
 var a2e string = " \x01\x02\x037-./\x16\x05%\x0b\x0c\r\x0e\x0f\x10\x11\x12\x13<=2&\x18\x19?'\x1c\x1d\x1e\x1f@O\x7f{[lP}M]\\Nk`Ka\xf0\xf1\xf2\xf3\xf4\xf5\xf6\xf7\xf8\xf9z^L~no|\xc1\xc2\xc3\xc4\xc5\xc6\xc7\xc8\xc9\xd1\xd2\xd3\xd4\xd5\xd6\xd7\xd8\xd9\xe2\xe3\xe4\xe5\xe6\xe7\xe8\xe9J\xe0Z_my\x81\x82\x83\x84\x85\x86\x87\x88\x89\x91\x92\x93\x94\x95\x96\x97\x98\x99\xa2\xa3\xa4\xa5\xa6\xa7\xa8\xa9\xc0j\xd0\xa1\x07 !\"#$\x15\x06\x17()*+,\t\n\x1b01\x1a3456\x0889:;\x04\x14>\xe1ABCDEFGHIQRSTUVWXYbcdefghipqrstuvwx\x80\x8a\x8b\x8c\x8d\x8e\x8f\x90\x9a\x9b\x9c\x9d\x9e\x9f\xa0\xaa\xab\xac\xad\xae\xaf\xb0\xb1\xb2\xb3\xb4\xb5\xb6\xb7\xb8\xb9\xba\xbb\xbc\xbd\xbe\xbf\xca\xcb\xcc\xcd\xce\xcf\xda\xdb\xdc\xdd\xde\xdf\xea\xeb\xec\xed\xee\xef\xfa\xfb\xfc\xfd\xfe\xff"
 
+// E2A converts CP037 EBCDIC to Latin-1, see CodePage for other code pages
 func E2A(src []byte) (res []byte) {
 	res = make([]byte, len(src))
 	for i, b := range src {
@@ -33,6 +33,7 @@ func E2A(src []byte) (res []byte) {
 	return
 }
 
+// A2E converts Latin-1 to CP037 EBCDIC, see CodePage for other code pages
 func A2E(src []byte) (res []byte) {
 	res = make([]byte, len(src))
 	for i, b := range src {
@@ -41,6 +42,7 @@ func A2E(src []byte) (res []byte) {
 	return
 }
 
+// E2D is like E2A but displays field attributes as blanks
 func E2D(src []byte) (res []byte) {
 	res = make([]byte, len(src))
 	for i, b := range src {
@@ -54,6 +56,13 @@ type TNHandler interface {
 	OnTNArgCommand(byte, byte)
 }
 
+// TNSubnegotiationHandler is implemented by TNHandlers that handle the
+// subnegotiations of other options than TN3270E. It reports whether the
+// option is handled.
+type TNSubnegotiationHandler interface {
+	OnTNSubnegotiation(option byte, data []byte) bool
+}
+
 type TN3270NegoHandler interface {
 	OnTN3270DeviceTypeRequest([]byte, []byte, []byte)
 	OnTN3270DeviceTypeIs([]byte, []byte)
@@ -78,6 +87,15 @@ type TN3270Handler interface {
 	OnTN3270Message()
 }
 
+// TN3270AttributeHandler is implemented by TN3270 handlers that keep track
+// of extended attributes. OnTN3270FieldAttribute is called with each
+// type/value pair of a Start Field Extended order, OnTN3270SA with the pair
+// of a Set Attribute order.
+type TN3270AttributeHandler interface {
+	OnTN3270FieldAttribute(byte, byte)
+	OnTN3270SA(byte, byte)
+}
+
 type ErrorHandler interface {
 	OnError([]byte, int) error
 }
@@ -96,7 +114,10 @@ type TextTN3270Handler struct {
 	lines []string
 	line  []string
 	rows   int
+	field  byte // character set of the current field
+	sa     byte // character set selected by SA orders
 
+	CodePage      *CodePage // CP037 if nil
 	HandleMessage func(string)
 }
 func (h *TextTN3270Handler) lineFeed() {
@@ -109,9 +130,16 @@ func (h *TextTN3270Handler) OnTN3270Command(byte) {
 	// Clear screen for each command
 	h.lines = h.lines[0:0]
 	h.line = h.line[0:0]
+	h.field = 0
+	h.sa = 0
 }
 func (h *TextTN3270Handler) OnTN3270Text(text []byte) {
-	h.line = append(h.line, string(E2A(text)))
+	cp := codePageOrDefault(h.CodePage)
+	if cp.IsDBCS() && (h.sa == charsetDBCS || (h.sa == 0 && h.field == charsetDBCS)) {
+		h.line = append(h.line, cp.decodeDBCS(text))
+		return
+	}
+	h.line = append(h.line, cp.Decode(text))
 }
 func (h *TextTN3270Handler) OnTN3270WCC(byte) {
 	// Do nothing
@@ -126,10 +154,20 @@ func (h *TextTN3270Handler) OnTN3270IC() {
 	// Do nothing
 }
 func (h *TextTN3270Handler) OnTN3270SF(byte) {
-	// Do nothing
+	h.field = 0
 }
 func (h *TextTN3270Handler) OnTN3270SFE(byte) {
-	// Do nothing
+	h.field = 0
+}
+func (h *TextTN3270Handler) OnTN3270FieldAttribute(t byte, v byte) {
+	if t == 0x43 {
+		h.field = v
+	}
+}
+func (h *TextTN3270Handler) OnTN3270SA(t byte, v byte) {
+	if t == 0x00 || t == 0x43 {
+		h.sa = v
+	}
 }
 func (h *TextTN3270Handler) OnTN3270RA(int, byte) {
 	// Do nothing
@@ -151,7 +189,27 @@ func (h *TextTN3270Handler) String() string {
 // MultiHandler is a TN3270 handler that wraps several handlers into one
 // all handlers are called for each function
 type MultiHandler struct {
-	handlers []*MultiHandler
+	handlers []TN3270Handler
+}
+
+func NewMultiHandler(handlers ...TN3270Handler) *MultiHandler {
+	return &MultiHandler{handlers: handlers}
+}
+
+func (h *MultiHandler) OnTN3270FieldAttribute(t byte, v byte) {
+	for _, h1 := range h.handlers {
+		if ah, ok := h1.(TN3270AttributeHandler); ok {
+			ah.OnTN3270FieldAttribute(t, v)
+		}
+	}
+}
+
+func (h *MultiHandler) OnTN3270SA(t byte, v byte) {
+	for _, h1 := range h.handlers {
+		if ah, ok := h1.(TN3270AttributeHandler); ok {
+			ah.OnTN3270SA(t, v)
+		}
+	}
 }
 
 func (h* MultiHandler) OnTN3270Command(b byte) {
@@ -216,110 +274,10 @@ func (h* MultiHandler) OnTN3270Message() {
 }
 
 
-// VirtualScreenTN3270Handler is a TN3270 handler that simulates a terminal
-// and keeps track of the terminal display as if it was a GUI
-type VirtualScreenTN3270Handler struct {
-	screen           Screen
-	rows, cols       int
-	position, cursor int
-
-	HandleMessage func(string)
-}
-
-func (h *VirtualScreenTN3270Handler) String() string {
-	rows := make([][]byte, h.rows)
-	for i := 0; i < h.rows; i++ {
-		rows[i] = bytes.TrimRight(E2D(h.screen[i*h.cols:(i+1)*h.cols]), " ")
-	}
-	// Trim empty lines
-	var beg, end int
-	var started bool = false
-	for i := 0; i < h.rows; i++ {
-		if len(rows[i]) != 0 {
-			if !started {
-				beg = i
-			}
-			started = true
-			end = i
-		}
-	}
-	// Generate screen string
-	var sep []byte = []byte{'\n'}
-	return string(bytes.Join(rows[beg:end+1], sep))
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270Command(b byte) {
-	switch b {
-	case 0x05, 0xf5:
-		// Clear screen
-		h.screen = make([]byte, h.rows*h.cols)
-		h.position = 0
-	}
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270WCC(b byte) {
-	// Nothing to be done on WCC
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270AID(b byte) {
-	// Nothing to be done on AID
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270SF(b byte) {
-	h.screen[h.position] = 0x1d
-	h.position = (h.position + 1) % (h.rows * h.cols)
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270SFE(b byte) {
-	h.screen[h.position] = 0x1d
-	h.position = (h.position + 1) % (h.rows * h.cols)
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270PT() {
-	// Move to next start field
-	var idx int
-	for i := 0; i < h.rows*h.cols; i++ {
-		idx = (h.position + i) % (h.rows * h.cols)
-		if h.screen[idx] == 0x1d {
-			break
-		}
-		h.screen[idx] = 0x00
-	}
-	h.position = (idx + 1) % (h.rows * h.cols)
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270IC() {
-	h.cursor = h.position
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270Text(b []byte) {
-	copy(h.screen[h.position:h.position+len(b)], b)
-	h.position = (h.position + len(b)) % (h.rows * h.cols)
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270RA(addr int, b byte) {
-	for ; h.position != addr; h.position = (h.position + 1) % (h.rows * h.cols) {
-		h.screen[h.position] = b
-	}
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270SBA(addr int) {
-	h.position = addr
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270EUA(addr int) {
-	for ; h.position != addr; h.position = (h.position + 1) % (h.rows * h.cols) {
-		h.screen[h.position] = 0x00
-	}
-}
-
-func (h *VirtualScreenTN3270Handler) OnTN3270Message() {
-	h.HandleMessage(h.String())
-}
-
 // VerboseTN3270Handler is a handler that prints all the TN3270 commands to
 // stdout
 type VerboseTN3270Handler struct {
+	CodePage *CodePage // CP037 if nil
 }
 
 func (h *VerboseTN3270Handler) OnTN3270Command(b byte) {
@@ -351,7 +309,7 @@ func (h *VerboseTN3270Handler) OnTN3270IC() {
 }
 
 func (h *VerboseTN3270Handler) OnTN3270Text(b []byte) {
-	fmt.Println("TN3270 Text: ", string(E2A(b)))
+	fmt.Println("TN3270 Text: ", codePageOrDefault(h.CodePage).Decode(b))
 }
 
 func (h *VerboseTN3270Handler) OnTN3270RA(addr int, b byte) {
@@ -374,5 +332,14 @@ func NewParser(tnh TNHandler, tn3270negoh TN3270NegoHandler, tn3270h TN3270Handl
 	screen := tn3270h
 	p.tn3270h = screen
 
+	_, nvt := tn3270negoh.(NVTHandler)
+	return &telnetParser{next: p, tn3270negoh: tn3270negoh, errorh: errorh, nvt: nvt}
+}
+
+// newInboundParser returns a parser for the data sent by terminals
+func newInboundParser(tnh TNHandler, tn3270negoh TN3270NegoHandler, tn3270h TN3270Handler, errorh ErrorHandler) Parser {
+	p := NewParser(tnh, tn3270negoh, tn3270h, errorh).(*telnetParser)
+	p.inbound = true
+	p.nvt = false
 	return p
 }
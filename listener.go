@@ -0,0 +1,174 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"crypto/tls"
+	"net"
+	"sync"
+	"sync/atomic"
+)
+
+// tracker keeps the listeners and the connections of a Server or a Proxy
+// so that Close stops them all
+type tracker struct {
+	mu         sync.Mutex
+	inShutdown int32 // accessed atomically (non-zero means we're in Shutdown)
+	listeners  map[*net.Listener]struct{}
+	conns      map[interface{}]func() // closes each connection
+	wg         sync.WaitGroup         // goroutines serving the connections
+}
+
+func (t *tracker) shuttingDown() bool {
+	return atomic.LoadInt32(&t.inShutdown) != 0
+}
+
+// listenAndServe listens on the TCP network address addr, def if empty,
+// and then calls serve
+func (t *tracker) listenAndServe(addr, def string, serve func(net.Listener) error) error {
+	if t.shuttingDown() {
+		return ErrServerClosed
+	}
+	if addr == "" {
+		addr = def
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	return serve(l)
+}
+
+// cloneTLSConfig returns a shallow clone of cfg, or a new zero tls.Config if
+// cfg is nil. This is safe to call even if cfg is in active use by a TLS
+// client or server.
+func cloneTLSConfig(cfg *tls.Config) *tls.Config {
+	if cfg == nil {
+		return &tls.Config{}
+	}
+	return cfg.Clone()
+}
+
+// tlsListener wraps l to accept TLS connections, the certificate is loaded
+// from the files if config holds none
+func tlsListener(l net.Listener, config *tls.Config, certFile, keyFile string) (net.Listener, error) {
+	config = cloneTLSConfig(config)
+	configHasCert := len(config.Certificates) > 0 || config.GetCertificate != nil
+	if !configHasCert || certFile != "" || keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return tls.NewListener(l, config), nil
+}
+
+// serve accepts incoming connections on l and calls handle for each of
+// them in its own goroutine, until l fails or is closed by close
+func (t *tracker) serve(l net.Listener, handle func(net.Conn)) error {
+	defer l.Close()
+
+	if !t.trackListener(&l, true) {
+		return ErrServerClosed
+	}
+	defer t.trackListener(&l, false)
+
+	for {
+		rw, err := l.Accept()
+		if err != nil {
+			if t.shuttingDown() {
+				return nil
+			}
+			return err
+		}
+		t.wg.Add(1)
+		go func() {
+			defer t.wg.Done()
+			handle(rw)
+		}()
+	}
+}
+
+// trackListener adds or removes a net.Listener to the set of tracked
+// listeners.
+//
+// We store a pointer to interface in the map set, in case the
+// net.Listener is not comparable. This is safe because we only call
+// trackListener via serve and can track+defer untrack the same
+// pointer to local variable there. We never need to compare a
+// Listener from another caller.
+//
+// It reports whether the server is still up (not Shutdown or Closed).
+func (t *tracker) trackListener(ln *net.Listener, add bool) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.listeners == nil {
+		t.listeners = make(map[*net.Listener]struct{})
+	}
+	if add {
+		if t.shuttingDown() {
+			return false
+		}
+		t.listeners[ln] = struct{}{}
+	} else {
+		delete(t.listeners, ln)
+	}
+	return true
+}
+
+// trackConn adds a connection closed by close, it reports false and closes
+// the connection if the server is already closed
+func (t *tracker) trackConn(c interface{}, close func()) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.shuttingDown() {
+		close()
+		return false
+	}
+	if t.conns == nil {
+		t.conns = make(map[interface{}]func())
+	}
+	t.conns[c] = close
+	return true
+}
+
+func (t *tracker) untrackConn(c interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.conns, c)
+}
+
+// close closes the listeners and the connections, then waits for the
+// goroutines serving them to return
+func (t *tracker) close() error {
+	atomic.StoreInt32(&t.inShutdown, 1)
+	t.mu.Lock()
+	var err error
+	for ln := range t.listeners {
+		if cerr := (*ln).Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+		delete(t.listeners, ln)
+	}
+	for c, close := range t.conns {
+		close()
+		delete(t.conns, c)
+	}
+	t.mu.Unlock()
+	t.wg.Wait()
+	return err
+}
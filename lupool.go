@@ -0,0 +1,195 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+var (
+	// ErrLUInUse is returned when the requested LU is already assigned
+	ErrLUInUse = errors.New("LU in use")
+	// ErrLUUnknown is returned when the requested LU is not part of the pool
+	ErrLUUnknown = errors.New("Unknown LU")
+	// ErrLUExhausted is returned when no generic LU is left in the pool
+	ErrLUExhausted = errors.New("No LU available")
+	// ErrNoAssociation is returned when an ASSOCIATE request does not match
+	// an active terminal session with a printer LU
+	ErrNoAssociation = errors.New("No printer associated to the device")
+)
+
+// LUPool keeps track of the LU names a Server can hand out.
+//
+// Generic requests (no CONNECT) are served from the ranges added with
+// AddRange, in order. Specific requests may name any LU of the pool,
+// including the ones added with AddSpecific which are never handed out
+// generically. Printer LUs registered with AddPrinter are assigned to
+// ASSOCIATE requests naming the terminal LU they are tied to.
+type LUPool struct {
+	mu       sync.Mutex
+	generic  []string
+	known    map[string]bool
+	inUse    map[string]bool
+	printers map[string]string
+}
+
+func NewLUPool() *LUPool {
+	return &LUPool{
+		known:    make(map[string]bool),
+		inUse:    make(map[string]bool),
+		printers: make(map[string]string),
+	}
+}
+
+// AddRange adds all the LU names from first to last to the generic pool.
+// Both names must share the same prefix and end with a numeric suffix of the
+// same width, e.g. "TCP00001" and "TCP00050".
+func (p *LUPool) AddRange(first, last string) error {
+	names, err := LURange(first, last)
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, name := range names {
+		if !p.known[name] {
+			p.known[name] = true
+			p.generic = append(p.generic, name)
+		}
+	}
+	return nil
+}
+
+// AddSpecific adds LU names that can only be obtained by a CONNECT request
+func (p *LUPool) AddSpecific(names ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, name := range names {
+		p.known[name] = true
+	}
+}
+
+// AddPrinter ties the printer LU to the terminal LU. An ASSOCIATE request
+// naming the terminal gets the printer LU while the terminal is in use.
+func (p *LUPool) AddPrinter(terminal, printer string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.known[printer] = true
+	p.printers[terminal] = printer
+}
+
+// Printer returns the printer LU tied to the terminal LU
+func (p *LUPool) Printer(terminal string) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	printer, ok := p.printers[terminal]
+	return printer, ok
+}
+
+// Acquire assigns an LU. An empty name requests a generic LU.
+func (p *LUPool) Acquire(name string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if name == "" {
+		for _, lu := range p.generic {
+			if !p.inUse[lu] {
+				p.inUse[lu] = true
+				return lu, nil
+			}
+		}
+		return "", ErrLUExhausted
+	}
+	if !p.known[name] {
+		return "", ErrLUUnknown
+	}
+	if p.inUse[name] {
+		return "", ErrLUInUse
+	}
+	p.inUse[name] = true
+	return name, nil
+}
+
+// Associate assigns the printer LU tied to the given terminal LU, which must
+// be in use
+func (p *LUPool) Associate(terminal string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	printer, ok := p.printers[terminal]
+	if !ok || !p.inUse[terminal] {
+		return "", ErrNoAssociation
+	}
+	if p.inUse[printer] {
+		return "", ErrLUInUse
+	}
+	p.inUse[printer] = true
+	return printer, nil
+}
+
+// Release gives an LU back to the pool
+func (p *LUPool) Release(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.inUse, name)
+}
+
+// InUse reports whether the LU is currently assigned
+func (p *LUPool) InUse(name string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.inUse[name]
+}
+
+// LURange returns all the LU names from first to last, see LUPool.AddRange
+func LURange(first, last string) ([]string, error) {
+	prefix, from, width := splitLUName(first)
+	lastPrefix, to, lastWidth := splitLUName(last)
+	if width == 0 || prefix != lastPrefix || width != lastWidth || from > to {
+		return nil, fmt.Errorf("Invalid LU range %s-%s", first, last)
+	}
+	var names []string
+	for i := from; i <= to; i++ {
+		names = append(names, fmt.Sprintf("%s%0*d", prefix, width, i))
+	}
+	return names, nil
+}
+
+// splitLUName splits an LU name into its prefix and numeric suffix
+func splitLUName(name string) (prefix string, n int, width int) {
+	i := len(name)
+	for i > 0 && name[i-1] >= '0' && name[i-1] <= '9' {
+		i--
+	}
+	if i == len(name) {
+		return name, 0, 0
+	}
+	n, _ = strconv.Atoi(name[i:])
+	return name[:i], n, len(name) - i
+}
+
+// rejectReason maps an LU assignment error to a DEVICE-TYPE REJECT reason
+func rejectReason(err error) ReasonCode {
+	switch err {
+	case ErrLUInUse, ErrLUExhausted:
+		return ReasonDeviceInUse
+	case ErrLUUnknown, ErrNoCertificate, ErrCertificateNotMapped, ErrCertificateLU:
+		return ReasonInvName
+	case ErrNoAssociation:
+		return ReasonInvAssociate
+	}
+	return ReasonUnknownError
+}
@@ -0,0 +1,136 @@
+package tn3270_test
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+// requestDeviceType goes through the TN3270E negotiation up to the
+// DEVICE-TYPE REQUEST and returns the server's answer
+func requestDeviceType(conn net.Conn, request []byte) []byte {
+	conn.SetDeadline(time.Now().Add(2 * time.Second))
+	readUntil(conn, []byte{0xff, 0xfd, 0x28})
+	conn.Write([]byte{0xff, 0xfb, 0x28})
+	readUntil(conn, []byte{0xff, 0xf0})
+	conn.Write(request)
+	return readUntil(conn, []byte{0xff, 0xf0})
+}
+
+func readUntil(r io.Reader, suffix []byte) []byte {
+	var data []byte
+	b := make([]byte, 1)
+	for !bytes.HasSuffix(data, suffix) {
+		_, err := r.Read(b)
+		Expect(err).To(Succeed())
+		data = append(data, b[0])
+	}
+	return data
+}
+
+var _ = Describe("LU pool", func() {
+	var pool *tn3270.LUPool
+
+	BeforeEach(func() {
+		pool = tn3270.NewLUPool()
+		Expect(pool.AddRange("TCP00001", "TCP00002")).To(Succeed())
+		pool.AddSpecific("SPECIAL")
+		pool.AddPrinter("TCP00001", "PRT00001")
+	})
+
+	It("Should hand out generic LUs in order", func() {
+		Expect(pool.Acquire("")).To(Equal("TCP00001"))
+		Expect(pool.Acquire("")).To(Equal("TCP00002"))
+		_, err := pool.Acquire("")
+		Expect(err).To(Equal(tn3270.ErrLUExhausted))
+		pool.Release("TCP00001")
+		Expect(pool.Acquire("")).To(Equal("TCP00001"))
+	})
+
+	It("Should honor specific requests", func() {
+		Expect(pool.Acquire("SPECIAL")).To(Equal("SPECIAL"))
+		_, err := pool.Acquire("SPECIAL")
+		Expect(err).To(Equal(tn3270.ErrLUInUse))
+		_, err = pool.Acquire("UNKNOWN")
+		Expect(err).To(Equal(tn3270.ErrLUUnknown))
+	})
+
+	It("Should associate printers to active terminals only", func() {
+		_, err := pool.Associate("TCP00001")
+		Expect(err).To(Equal(tn3270.ErrNoAssociation))
+		Expect(pool.Acquire("TCP00001")).To(Equal("TCP00001"))
+		Expect(pool.Associate("TCP00001")).To(Equal("PRT00001"))
+	})
+
+	It("Should reject invalid ranges", func() {
+		Expect(pool.AddRange("TCP001", "LU002")).NotTo(Succeed())
+		Expect(pool.AddRange("TCP002", "TCP001")).NotTo(Succeed())
+	})
+
+	Describe("Server negotiation", func() {
+		var server *tn3270.Server
+		var addr string
+
+		BeforeEach(func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).To(Succeed())
+			addr = listener.Addr().String()
+			server = &tn3270.Server{Handler: &MyHandler{}, LUPool: pool}
+			go server.Serve(listener)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		dial := func() net.Conn {
+			conn, err := net.Dial("tcp", addr)
+			Expect(err).To(Succeed())
+			return conn
+		}
+
+		It("Should assign a generic LU", func() {
+			conn := dial()
+			defer conn.Close()
+			reply := requestDeviceType(conn, []byte("\xff\xfa\x28\x02\x07IBM-3278-2-E\xff\xf0"))
+			Expect(reply).To(Equal([]byte("\xff\xfa\x28\x02\x04IBM-3278-2-E\x01TCP00001\xff\xf0")))
+		})
+
+		It("Should reject an LU in use", func() {
+			conn := dial()
+			defer conn.Close()
+			requestDeviceType(conn, []byte("\xff\xfa\x28\x02\x07IBM-3278-2-E\x01SPECIAL\xff\xf0"))
+			other := dial()
+			defer other.Close()
+			reply := requestDeviceType(other, []byte("\xff\xfa\x28\x02\x07IBM-3278-2-E\x01SPECIAL\xff\xf0"))
+			Expect(reply).To(Equal([]byte("\xff\xfa\x28\x02\x06\x05\x01\xff\xf0")))
+		})
+
+		It("Should reject unknown names and device types", func() {
+			conn := dial()
+			defer conn.Close()
+			reply := requestDeviceType(conn, []byte("\xff\xfa\x28\x02\x07IBM-3278-2-E\x01NOPE\xff\xf0"))
+			Expect(reply).To(Equal([]byte("\xff\xfa\x28\x02\x06\x05\x03\xff\xf0")))
+			other := dial()
+			defer other.Close()
+			reply = requestDeviceType(other, []byte("\xff\xfa\x28\x02\x07VT100\xff\xf0"))
+			Expect(reply).To(Equal([]byte("\xff\xfa\x28\x02\x06\x05\x04\xff\xf0")))
+		})
+
+		It("Should associate a printer to a terminal session", func() {
+			conn := dial()
+			defer conn.Close()
+			requestDeviceType(conn, []byte("\xff\xfa\x28\x02\x07IBM-3278-2-E\xff\xf0"))
+			printer := dial()
+			defer printer.Close()
+			reply := requestDeviceType(printer, []byte("\xff\xfa\x28\x02\x07IBM-3287-1\x00TCP00001\xff\xf0"))
+			Expect(reply).To(Equal([]byte("\xff\xfa\x28\x02\x04IBM-3287-1\x01PRT00001\xff\xf0")))
+		})
+	})
+})
@@ -0,0 +1,126 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"io"
+	"log"
+	"runtime/debug"
+	"time"
+)
+
+// Logger is the logging interface used by the package, *log.Logger
+// implements it
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// Middleware wraps a Handler into another one
+type Middleware func(Handler) Handler
+
+// Chain wraps h with the middlewares, the first one being the outermost
+func Chain(h Handler, middlewares ...Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
+
+// stdLogger logs through the standard logger of the log package
+type stdLogger struct{}
+
+func (stdLogger) Printf(format string, v ...interface{}) {
+	log.Printf(format, v...)
+}
+
+// middlewareHandler builds a Handler out of two functions
+type middlewareHandler struct {
+	welcome func(ResponseWriter)
+	serve   func(ResponseWriter, *Request)
+}
+
+func (h *middlewareHandler) ServeWelcomeScreen(w ResponseWriter) {
+	h.welcome(w)
+}
+
+func (h *middlewareHandler) ServeTN3270(w ResponseWriter, r *Request) {
+	h.serve(w, r)
+}
+
+// Recover returns a middleware that recovers from panics in the handler,
+// logs them and displays the given error screen instead
+func Recover(screen string) Middleware {
+	return func(next Handler) Handler {
+		recoverPanic := func(w ResponseWriter, lu string) {
+			if err := recover(); err != nil {
+				log.Printf("tn3270: panic serving %s: %v\n%s", lu, err, debug.Stack())
+				io.WriteString(w, screen)
+			}
+		}
+		return &middlewareHandler{
+			welcome: func(w ResponseWriter) {
+				defer recoverPanic(w, "welcome screen")
+				next.ServeWelcomeScreen(w)
+			},
+			serve: func(w ResponseWriter, r *Request) {
+				defer recoverPanic(w, r.LUName)
+				next.ServeTN3270(w, r)
+			},
+		}
+	}
+}
+
+// Logging returns a middleware that logs every request with its LU, AID and
+// latency. The standard logger is used if logger is nil.
+func Logging(logger Logger) Middleware {
+	if logger == nil {
+		logger = stdLogger{}
+	}
+	return func(next Handler) Handler {
+		return &middlewareHandler{
+			welcome: next.ServeWelcomeScreen,
+			serve: func(w ResponseWriter, r *Request) {
+				start := time.Now()
+				next.ServeTN3270(w, r)
+				logger.Printf("lu=%s aid=%s screen=%q latency=%s", r.LUName, r.AID, screenName(r), time.Since(start))
+			},
+		}
+	}
+}
+
+// RequireSignOn returns a middleware that sends every request of a session
+// to the signOn handler until it sets the session user. signOn also serves
+// the welcome screen.
+func RequireSignOn(signOn Handler) Middleware {
+	return func(next Handler) Handler {
+		return &middlewareHandler{
+			welcome: signOn.ServeWelcomeScreen,
+			serve: func(w ResponseWriter, r *Request) {
+				if r.Session == nil || r.Session.User == "" {
+					signOn.ServeTN3270(w, r)
+					return
+				}
+				next.ServeTN3270(w, r)
+			},
+		}
+	}
+}
+
+func screenName(r *Request) string {
+	if r.Session == nil {
+		return ""
+	}
+	return r.Session.Screen
+}
@@ -0,0 +1,74 @@
+package tn3270_test
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+var _ = Describe("Middleware", func() {
+	var server *tn3270.Server
+	var addr string
+
+	start := func(h tn3270.Handler, middlewares ...tn3270.Middleware) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(Succeed())
+		addr = listener.Addr().String()
+		server = &tn3270.Server{Handler: h, Middleware: middlewares}
+		go server.Serve(listener)
+	}
+
+	connect := func() *tn3270.Client {
+		client := tn3270.NewClient("09123456")
+		recv, err := client.Connect(addr)
+		Expect(err).To(Succeed())
+		<-recv
+		return client
+	}
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Should display an error screen on panic", func() {
+		mux := tn3270.NewServeMux()
+		mux.HandleDefault(&MyHandler{})
+		mux.HandleTransaction("BOOM", tn3270.HandlerFunc(func(tn3270.ResponseWriter, *tn3270.Request) {
+			panic("boom")
+		}))
+		start(mux, tn3270.Recover("SYSTEM ERROR"))
+		client := connect()
+		Expect(client.SendRecv("BOOM")).To(Equal("SYSTEM ERROR"))
+		Expect(client.SendRecv("Hello")).To(Equal("ECHO: Hello"))
+	})
+
+	It("Should log requests", func() {
+		var buf bytes.Buffer
+		start(&MyHandler{}, tn3270.Logging(log.New(&buf, "", 0)))
+		client := connect()
+		client.SendRecv("Hello")
+		Expect(buf.String()).To(HavePrefix("lu=09123456 aid=Enter screen=\"\" latency="))
+	})
+
+	It("Should require sign-on", func() {
+		signOn := tn3270.HandlerFunc(func(w tn3270.ResponseWriter, r *tn3270.Request) {
+			if r.Text == "SECRET" {
+				r.Session.User = "JOHN"
+				io.WriteString(w, "SIGNED ON")
+				return
+			}
+			io.WriteString(w, "PASSWORD?")
+		})
+		start(&MyHandler{}, tn3270.RequireSignOn(signOn))
+		client := connect()
+		Expect(client.SendRecv("Hello")).To(Equal("PASSWORD?"))
+		Expect(client.SendRecv("SECRET")).To(Equal("SIGNED ON"))
+		Expect(client.SendRecv("Hello")).To(Equal("ECHO: Hello"))
+	})
+})
@@ -0,0 +1,193 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"crypto/x509"
+	"io"
+	"strings"
+	"sync"
+)
+
+// HandlerFunc is an adapter to allow the use of ordinary functions as
+// handlers. It does not serve any welcome screen.
+type HandlerFunc func(ResponseWriter, *Request)
+
+func (f HandlerFunc) ServeTN3270(w ResponseWriter, r *Request) {
+	f(w, r)
+}
+
+func (f HandlerFunc) ServeWelcomeScreen(ResponseWriter) {
+}
+
+// Session holds the state of a client connection across requests
+type Session struct {
+	// Screen is the name of the screen displayed on the terminal. It is set
+	// by handlers and used by ServeMux to route the next request.
+	Screen string
+	// User is the authenticated user, empty until the user signs on. It
+	// is set from the client certificate, see Server.MapCertificate.
+	User string
+	// Certificate is the client certificate of the terminal, nil without
+	// TLS or certificate
+	Certificate *x509.Certificate
+	// Application is the application the terminal logged on to from the
+	// SSCP-LU session, see Server.USSMessage
+	Application string
+	// TerminalType is the terminal type sent in the TERMINAL-TYPE
+	// subnegotiation, empty if the terminal did not send one
+	TerminalType string
+	// Environ are the NEW-ENVIRON variables sent by the terminal, see
+	// Server.Environ
+	Environ map[string]string
+
+	values map[string]interface{}
+}
+
+// setEnviron updates the NEW-ENVIRON variables, undefined ones are removed
+func (s *Session) setEnviron(vars []envVar) {
+	if s.Environ == nil {
+		s.Environ = make(map[string]string)
+	}
+	for _, v := range vars {
+		if v.hasValue {
+			s.Environ[v.name] = v.value
+		} else {
+			delete(s.Environ, v.name)
+		}
+	}
+}
+
+// Get returns the value stored for key, or nil
+func (s *Session) Get(key string) interface{} {
+	return s.values[key]
+}
+
+// Set stores a value in the session
+func (s *Session) Set(key string, value interface{}) {
+	if s.values == nil {
+		s.values = make(map[string]interface{})
+	}
+	s.values[key] = value
+}
+
+// ServeMux is a request multiplexer that lets several applications share a
+// Server. Requests are routed, in order of precedence:
+//
+//   - by the screen name stored in the session,
+//   - by transaction code, the first word of the input (case insensitive),
+//   - by AID key,
+//
+// and go to the default handler when nothing matches. The default handler
+// also serves the welcome screen.
+type ServeMux struct {
+	mu           sync.RWMutex
+	screens      map[string]Handler
+	transactions map[string]Handler
+	aids         map[AID]Handler
+	def          Handler
+	middlewares  []Middleware
+}
+
+func NewServeMux() *ServeMux {
+	return &ServeMux{
+		screens:      make(map[string]Handler),
+		transactions: make(map[string]Handler),
+		aids:         make(map[AID]Handler),
+	}
+}
+
+// HandleScreen registers the handler for requests sent from the named screen
+func (mux *ServeMux) HandleScreen(name string, h Handler) {
+	mux.mu.Lock()
+	defer mux.mu.Unlock()
+	mux.screens[name] = h
+}
+
+// HandleTransaction registers the handler for a transaction code
+func (mux *ServeMux) HandleTransaction(code string, h Handler) {
+	mux.mu.Lock()
+	defer mux.mu.Unlock()
+	mux.transactions[strings.ToUpper(code)] = h
+}
+
+// HandleAID registers the handler for requests sent with an AID key
+func (mux *ServeMux) HandleAID(aid AID, h Handler) {
+	mux.mu.Lock()
+	defer mux.mu.Unlock()
+	mux.aids[aid] = h
+}
+
+// HandleDefault registers the handler for unrouted requests and the welcome
+// screen
+func (mux *ServeMux) HandleDefault(h Handler) {
+	mux.mu.Lock()
+	defer mux.mu.Unlock()
+	mux.def = h
+}
+
+// Use appends middlewares applied to every handler of the mux. The first
+// middleware is the outermost one.
+func (mux *ServeMux) Use(middlewares ...Middleware) {
+	mux.mu.Lock()
+	defer mux.mu.Unlock()
+	mux.middlewares = append(mux.middlewares, middlewares...)
+}
+
+// Handler returns the handler to use for the request, never nil
+func (mux *ServeMux) Handler(r *Request) Handler {
+	mux.mu.RLock()
+	defer mux.mu.RUnlock()
+	if r.Session != nil && r.Session.Screen != "" {
+		if h, ok := mux.screens[r.Session.Screen]; ok {
+			return h
+		}
+	}
+	if fields := strings.Fields(r.Text); len(fields) > 0 {
+		if h, ok := mux.transactions[strings.ToUpper(fields[0])]; ok {
+			return h
+		}
+	}
+	if h, ok := mux.aids[r.AID]; ok {
+		return h
+	}
+	if mux.def != nil {
+		return mux.def
+	}
+	return HandlerFunc(notFound)
+}
+
+func (mux *ServeMux) ServeWelcomeScreen(w ResponseWriter) {
+	mux.mu.RLock()
+	h := mux.def
+	mux.mu.RUnlock()
+	if h != nil {
+		mux.wrap(h).ServeWelcomeScreen(w)
+	}
+}
+
+func (mux *ServeMux) ServeTN3270(w ResponseWriter, r *Request) {
+	mux.wrap(mux.Handler(r)).ServeTN3270(w, r)
+}
+
+func (mux *ServeMux) wrap(h Handler) Handler {
+	mux.mu.RLock()
+	defer mux.mu.RUnlock()
+	return Chain(h, mux.middlewares...)
+}
+
+func notFound(w ResponseWriter, r *Request) {
+	io.WriteString(w, "UNKNOWN TRANSACTION")
+}
@@ -0,0 +1,87 @@
+package tn3270_test
+
+import (
+	"bytes"
+	"io"
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+func reply(s string) tn3270.HandlerFunc {
+	return func(w tn3270.ResponseWriter, r *tn3270.Request) {
+		io.WriteString(w, s)
+	}
+}
+
+var _ = Describe("ServeMux", func() {
+	var mux *tn3270.ServeMux
+
+	serve := func(r *tn3270.Request) string {
+		var buf bytes.Buffer
+		mux.ServeTN3270(&buf, r)
+		return buf.String()
+	}
+
+	BeforeEach(func() {
+		mux = tn3270.NewServeMux()
+		mux.HandleTransaction("CEMT", reply("CEMT"))
+		mux.HandleScreen("MENU", reply("MENU"))
+		mux.HandleAID(tn3270.AIDPF3, reply("EXIT"))
+	})
+
+	It("Should route by transaction code", func() {
+		Expect(serve(&tn3270.Request{Text: "cemt inquire", AID: tn3270.AIDEnter})).To(Equal("CEMT"))
+	})
+
+	It("Should route by screen before transaction code", func() {
+		session := &tn3270.Session{Screen: "MENU"}
+		Expect(serve(&tn3270.Request{Text: "CEMT", AID: tn3270.AIDEnter, Session: session})).To(Equal("MENU"))
+	})
+
+	It("Should route by AID", func() {
+		Expect(serve(&tn3270.Request{AID: tn3270.AIDPF3})).To(Equal("EXIT"))
+	})
+
+	It("Should fall back to the default handler", func() {
+		Expect(serve(&tn3270.Request{Text: "HELLO"})).To(Equal("UNKNOWN TRANSACTION"))
+		mux.HandleDefault(reply("DEFAULT"))
+		Expect(serve(&tn3270.Request{Text: "HELLO"})).To(Equal("DEFAULT"))
+	})
+
+	It("Should apply middlewares in order", func() {
+		tag := func(s string) tn3270.Middleware {
+			return func(h tn3270.Handler) tn3270.Handler {
+				return tn3270.HandlerFunc(func(w tn3270.ResponseWriter, r *tn3270.Request) {
+					io.WriteString(w, s)
+					h.ServeTN3270(w, r)
+				})
+			}
+		}
+		mux.Use(tag("A"), tag("B"))
+		Expect(serve(&tn3270.Request{Text: "CEMT"})).To(Equal("ABCEMT"))
+	})
+
+	It("Should keep the screen across requests of a connection", func() {
+		mux.HandleDefault(&MyHandler{})
+		mux.HandleTransaction("MENU", tn3270.HandlerFunc(func(w tn3270.ResponseWriter, r *tn3270.Request) {
+			r.Session.Screen = "MENU"
+			io.WriteString(w, "MAIN MENU")
+		}))
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(Succeed())
+		server := &tn3270.Server{Handler: mux}
+		go server.Serve(listener)
+		defer server.Close()
+
+		client := tn3270.NewClient("09123456")
+		recv, err := client.Connect(listener.Addr().String())
+		Expect(err).To(Succeed())
+		Expect(<-recv).To(Equal("WELCOME TO MY TN3270 SERVER"))
+		Expect(client.SendRecv("MENU")).To(Equal("MAIN MENU"))
+		Expect(client.SendRecv("CEMT")).To(Equal("MENU"))
+	})
+})
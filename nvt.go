@@ -0,0 +1,155 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// NVTHandler is implemented by negotiation handlers that support NVT (line
+// mode) data. Parsers of such handlers start in NVT mode, where the data
+// of the host is not framed in records, until the host sends a record or
+// the client enables TN3270E, BINARY or END-OF-RECORD. The NVT-DATA records
+// of TN3270E are delivered too. It is called with the data as received,
+// IAC unescaped.
+type NVTHandler interface {
+	OnNVTData([]byte)
+}
+
+// tn3270Options are the telnet options that end NVT mode when enabled
+var tn3270Options = map[byte]bool{
+	0x00: true, // BINARY
+	0x19: true, // END-OF-RECORD
+	0x28: true, // TN3270E
+}
+
+// nvtLength returns the length of the NVT data at the beginning of buf, up
+// to the next telnet command, 0 if buf does not hold data yet, or -1 if the
+// data is a record after all.
+func nvtLength(buf []byte) int {
+	i := 0
+	for i < len(buf) {
+		if buf[i] != 0xff {
+			i++
+			continue
+		}
+		if i+1 == len(buf) {
+			// Wait for the rest of the command
+			return i
+		}
+		switch buf[i+1] {
+		case 0xff:
+			i += 2
+			continue
+		case 0xef: // EOR
+			return -1
+		}
+		return i
+	}
+	return i
+}
+
+// vtFilter removes the carriage returns, the NUL characters and the VT100
+// control sequences of NVT data. It keeps its state across calls since a
+// sequence may be split between two reads.
+type vtFilter struct {
+	state int
+}
+
+const (
+	vtText = iota
+	vtEscape
+	vtCSI // ESC [ parameters final
+	vtOSC // ESC ] text BEL or ST
+)
+
+func (f *vtFilter) filter(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	for _, b := range data {
+		switch f.state {
+		case vtText:
+			switch b {
+			case 0x1b:
+				f.state = vtEscape
+			case '\r', 0x00, 0x07:
+			default:
+				out = append(out, b)
+			}
+		case vtEscape:
+			switch b {
+			case '[':
+				f.state = vtCSI
+			case ']':
+				f.state = vtOSC
+			default:
+				f.state = vtText
+			}
+		case vtCSI:
+			if b >= 0x40 && b <= 0x7e {
+				f.state = vtText
+			}
+		case vtOSC:
+			if b == 0x07 || b == '\\' {
+				f.state = vtText
+			}
+		}
+	}
+	return out
+}
+
+// nvtBuffer keeps the NVT text received until it is read, writes never
+// block the parser
+type nvtBuffer struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	buf    bytes.Buffer
+	closed bool
+}
+
+func newNVTBuffer() *nvtBuffer {
+	b := &nvtBuffer{}
+	b.cond = sync.NewCond(&b.mu)
+	return b
+}
+
+func (b *nvtBuffer) write(data []byte) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf.Write(data)
+	b.cond.Broadcast()
+}
+
+func (b *nvtBuffer) close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	b.cond.Broadcast()
+}
+
+// Read waits for text, io.EOF is returned once the buffer is closed and
+// empty
+func (b *nvtBuffer) Read(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for b.buf.Len() == 0 && !b.closed {
+		b.cond.Wait()
+	}
+	if b.buf.Len() == 0 {
+		return 0, io.EOF
+	}
+	return b.buf.Read(p)
+}
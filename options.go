@@ -0,0 +1,246 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+// Telnet options
+const (
+	OptionBinary       byte = 0x00
+	OptionEcho         byte = 0x01
+	OptionSuppressGA   byte = 0x03
+	OptionTimingMark   byte = 0x06
+	OptionTerminalType byte = 0x18
+	OptionEOR          byte = 0x19
+	OptionNewEnviron   byte = 0x27
+	OptionTN3270E      byte = 0x28
+	OptionStartTLS     byte = 0x2e
+)
+
+// Telnet commands
+const (
+	tnSE   = 0xf0
+	tnAO   = 0xf5
+	tnSB   = 0xfa
+	tnWILL = 0xfb
+	tnWONT = 0xfc
+	tnDO   = 0xfd
+	tnDONT = 0xfe
+	tnIAC  = 0xff
+)
+
+// OptionHandler handles a telnet option, in addition to the ones the
+// clients and the servers of the package support. Options without handler
+// are refused.
+type OptionHandler struct {
+	// Local and Remote allow the option to be enabled on our side (DO
+	// requests are accepted) and on the side of the peer (WILL requests
+	// are accepted)
+	Local, Remote bool
+	// OnChange is called when the option is enabled or disabled on one
+	// side, and with enabled false when the peer refuses to enable it
+	OnChange func(local bool, enabled bool)
+	// OnSubnegotiation is called with the parameters of the subnegotiations
+	// of the option, it returns the parameters of the answer or nil
+	OnSubnegotiation func(data []byte) []byte
+}
+
+// Q-method states of an option on one side, see RFC 1143
+const (
+	qNo = iota
+	qYes
+	qWantNo
+	qWantYes
+)
+
+// optionState is the state of an option on both sides, the queue bits are
+// set when the opposite of the pending request is wanted
+type optionState struct {
+	us, him   int
+	usq, himq bool
+}
+
+// negotiator negotiates telnet options with the Q-method of RFC 1143, so
+// that options are acknowledged once and negotiation loops are avoided.
+// It is used by the goroutine parsing the data of the peer.
+type negotiator struct {
+	send     func([]byte)
+	handlers map[byte]OptionHandler
+	states   [256]optionState
+}
+
+func newNegotiator(send func([]byte), builtin map[byte]OptionHandler, custom map[byte]OptionHandler) *negotiator {
+	n := &negotiator{send: send, handlers: make(map[byte]OptionHandler)}
+	for opt, h := range custom {
+		n.handlers[opt] = h
+	}
+	// Built-in handlers take precedence, the package relies on them
+	for opt, h := range builtin {
+		n.handlers[opt] = h
+	}
+	return n
+}
+
+func (n *negotiator) command(cmd, opt byte) {
+	n.send([]byte{tnIAC, cmd, opt})
+}
+
+// enabled reports whether the option is enabled on one side
+func (n *negotiator) enabled(opt byte, local bool) bool {
+	s := &n.states[opt]
+	if local {
+		return s.us == qYes
+	}
+	return s.him == qYes
+}
+
+func (n *negotiator) changed(opt byte, local bool, enabled bool) {
+	if h, ok := n.handlers[opt]; ok && h.OnChange != nil {
+		h.OnChange(local, enabled)
+	}
+}
+
+// sides returns the fields and the commands of one side of an option: the
+// state, the queue bit, and the commands sent to enable and disable it
+func (n *negotiator) side(opt byte, local bool) (state *int, queue *bool, yes, no byte) {
+	s := &n.states[opt]
+	if local {
+		return &s.us, &s.usq, tnWILL, tnWONT
+	}
+	return &s.him, &s.himq, tnDO, tnDONT
+}
+
+// enable asks for the option to be enabled on one side
+func (n *negotiator) enable(opt byte, local bool) {
+	state, queue, yes, _ := n.side(opt, local)
+	switch *state {
+	case qNo:
+		*state = qWantYes
+		n.command(yes, opt)
+	case qWantNo:
+		*queue = true
+	case qWantYes:
+		*queue = false
+	}
+}
+
+// disable asks for the option to be disabled on one side
+func (n *negotiator) disable(opt byte, local bool) {
+	state, queue, _, no := n.side(opt, local)
+	switch *state {
+	case qYes:
+		*state = qWantNo
+		n.command(no, opt)
+		n.changed(opt, local, false)
+	case qWantYes:
+		*queue = true
+	case qWantNo:
+		*queue = false
+	}
+}
+
+// receive handles a WILL, WONT, DO or DONT of the peer. DO and DONT are
+// about our side, WILL and WONT about the side of the peer.
+func (n *negotiator) receive(cmd, opt byte) {
+	if opt == OptionTimingMark {
+		// The data before the mark is processed, see RFC 860
+		if cmd == tnDO {
+			n.command(tnWILL, opt)
+		}
+		return
+	}
+	local := cmd == tnDO || cmd == tnDONT
+	state, queue, yes, no := n.side(opt, local)
+	h := n.handlers[opt]
+	allowed := h.Remote
+	if local {
+		allowed = h.Local
+	}
+	if cmd == tnWILL || cmd == tnDO {
+		switch {
+		case *state == qNo && allowed:
+			*state = qYes
+			n.command(yes, opt)
+			n.changed(opt, local, true)
+		case *state == qNo:
+			n.command(no, opt)
+		case *state == qWantNo && !*queue:
+			// Answer to a request to disable, the peer is wrong
+			*state = qNo
+		case *state == qWantNo:
+			*state, *queue = qYes, false
+			n.changed(opt, local, true)
+		case *state == qWantYes && !*queue:
+			*state = qYes
+			n.changed(opt, local, true)
+		case *state == qWantYes:
+			*state, *queue = qWantNo, false
+			n.command(no, opt)
+		}
+		return
+	}
+	switch {
+	case *state == qYes:
+		*state = qNo
+		n.command(no, opt)
+		n.changed(opt, local, false)
+	case *state == qWantNo && *queue:
+		*state, *queue = qWantYes, false
+		n.command(yes, opt)
+	case *state == qWantYes && *queue:
+		*state, *queue = qNo, false
+	case *state == qWantYes:
+		// The peer refuses to enable the option
+		*state = qNo
+		n.changed(opt, local, false)
+	default:
+		*state = qNo
+	}
+}
+
+// allow changes whether the option may be enabled on one side at the
+// request of the peer
+func (n *negotiator) allow(opt byte, local bool, allowed bool) {
+	h := n.handlers[opt]
+	if local {
+		h.Local = allowed
+	} else {
+		h.Remote = allowed
+	}
+	n.handlers[opt] = h
+}
+
+// reset disables all the options without negotiation, as required once
+// the connection is upgraded by START_TLS
+func (n *negotiator) reset() {
+	n.states = [256]optionState{}
+}
+
+// subnegotiation hands the parameters of a subnegotiation to the handler
+// of the option, it reports whether the option has a handler
+func (n *negotiator) subnegotiation(opt byte, data []byte) bool {
+	h, ok := n.handlers[opt]
+	if !ok || h.OnSubnegotiation == nil {
+		return false
+	}
+	if answer := h.OnSubnegotiation(data); answer != nil {
+		n.subnegotiate(opt, answer)
+	}
+	return true
+}
+
+// subnegotiate sends a subnegotiation of the option
+func (n *negotiator) subnegotiate(opt byte, data []byte) {
+	sb := append([]byte{tnIAC, tnSB, opt}, escapeIAC(data)...)
+	n.send(append(sb, tnIAC, tnSE))
+}
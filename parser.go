@@ -56,14 +56,14 @@ func (parser *parser) StartTxt() {
 
 func (parser *parser) EndTxt() {
     if (parser.state.starttxt != -1 && parser.state.starttxt < parser.state.position) {
-        parser.tn3270h.OnTN3270Text(parser.state.data[parser.state.starttxt:parser.state.position])
+        parser.text(parser.state.data[parser.state.starttxt:parser.state.position])
     }
     parser.state.starttxt = -1
 }
 
 func (parser *parser) CaptureTxt() {
     if (parser.state.starttxt != -1 && parser.state.starttxt < parser.state.position) {
-        parser.tn3270h.OnTN3270Text(parser.state.data[parser.state.starttxt:parser.state.position])
+        parser.text(parser.state.data[parser.state.starttxt:parser.state.position])
         parser.state.starttxt = 0
     }
 }
@@ -8184,7 +8184,7 @@ state.command =  state.data[( state.position)];
 		case 6:
 // line 78 "ext/parser.rl"
 
- parser.tn3270h.OnTN3270WCC( state.data[( state.position)]);
+ parser.tn3270h.OnTN3270WCC( state.data[( state.position)]); parser.state.starttxt = ( state.position) + 1 
 		case 7:
 // line 79 "ext/parser.rl"
 
@@ -8312,7 +8312,7 @@ state.command =  state.data[( state.position)];
 		case 31:
 // line 135 "ext/parser.rl"
 
- state.count--; if(state.count == 0) {  state.top--;  state.cs =  state.stack[ state.top]
+ parser.attribute( state.data[( state.position)-1],  state.data[( state.position)]); state.count--; if(state.count == 0) {  state.top--;  state.cs =  state.stack[ state.top]
 goto _again
  }
 		case 32:
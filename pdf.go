@@ -0,0 +1,117 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Page geometry of the PDF files, US letter with half inch margins, in
+// points
+const (
+	pdfWidth   = 612
+	pdfHeight  = 792
+	pdfMargin  = 36
+	pdfMaxFont = 12
+)
+
+// WritePDF writes a job as a PDF document with one page per page of the
+// job. The text is set in Courier, as large as the longest line and the
+// longest page of the job allow. Characters outside of Latin-1 are
+// replaced by '?'.
+func WritePDF(w io.Writer, job *PrintJob) error {
+	cols, rows := 80, 66
+	for _, page := range job.Pages {
+		lines := strings.Split(page, "\n")
+		rows = max(rows, len(lines))
+		for _, line := range lines {
+			cols = max(cols, len([]rune(line)))
+		}
+	}
+	// Courier characters are 0.6 em wide, lines are 1.2 em high
+	size := float64(pdfMaxFont)
+	if s := float64(pdfWidth-2*pdfMargin) / (0.6 * float64(cols)); s < size {
+		size = s
+	}
+	if s := float64(pdfHeight-2*pdfMargin) / (1.2 * float64(rows)); s < size {
+		size = s
+	}
+
+	var buf bytes.Buffer
+	var offsets []int
+	object := func(format string, v ...interface{}) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n", len(offsets))
+		fmt.Fprintf(&buf, format, v...)
+		buf.WriteString("\nendobj\n")
+	}
+	pages := job.Pages
+	if len(pages) == 0 {
+		pages = []string{""}
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	// Objects 1 to 3 are the catalog, the page tree and the font, then
+	// each page is followed by its content
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	var kids []string
+	for i := range pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+	object("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	for i, page := range pages {
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n/F1 %.2f Tf\n%.2f TL\n%d %.2f Td\n", size, 1.2*size, pdfMargin, pdfHeight-pdfMargin-size)
+		for _, line := range strings.Split(page, "\n") {
+			fmt.Fprintf(&content, "(%s) Tj T*\n", pdfString(line))
+		}
+		content.WriteString("ET")
+		object("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfWidth, pdfHeight, 5+2*i)
+		object("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.Bytes())
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// pdfString escapes text for a PDF literal string in WinAnsiEncoding
+func pdfString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,379 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrPoolClosed is returned by Acquire once the pool is closed
+	ErrPoolClosed = errors.New("tn3270: pool closed")
+	// ErrNoWelcomeScreen is returned when the host does not send its
+	// welcome screen to a new session in time
+	ErrNoWelcomeScreen = errors.New("tn3270: no welcome screen")
+	// ErrPoolEmpty is returned by Acquire when the pool has neither Size
+	// nor LUs
+	ErrPoolEmpty = errors.New("tn3270: pool without sessions")
+)
+
+// Pool keeps client sessions to a host logged on and ready for use.
+//
+// Sessions are opened when the pool is first used, each one runs Logon
+// once connected. Idle sessions are checked every HealthCheckInterval, and
+// the sessions that fail, lose their connection or are discarded are
+// replaced.
+type Pool struct {
+	Addr      string      // host:port of the host
+	TLSConfig *tls.Config // connects over TLS if not nil
+	CodePage  *CodePage   // code page of the host, CP037 if nil
+
+	// Dial opens the connections to the host instead of dialing Addr if
+	// set
+	Dial func() (net.Conn, error)
+
+	// LUs are the LU names of the sessions, each one is used by one
+	// session at a time. Generic LUs are requested if empty.
+	LUs []string
+	// Size is the number of sessions kept open, len(LUs) if 0
+	Size int
+
+	// Logon logs a new session on, screen is the welcome screen of the
+	// host. The session is discarded if it returns an error.
+	Logon func(c *Client, screen string) error
+	// HealthCheck checks an idle session, which is replaced if it returns
+	// an error. Only the connection is checked if nil.
+	HealthCheck func(c *Client) error
+
+	HealthCheckInterval time.Duration // 1 minute if 0
+	Timeout             time.Duration // to connect and get the welcome screen, 30 seconds if 0
+	RetryDelay          time.Duration // before replacing a session that failed to open, 5 seconds if 0
+
+	// Logger logs the sessions that failed to open, the standard logger
+	// is used if nil
+	Logger Logger
+
+	once    sync.Once
+	mu      sync.Mutex
+	idle    []*Client
+	lus     map[*Client]string // LU of each open session
+	busy    map[*Client]bool   // sessions handed out by Acquire
+	free    []string           // LUs without a session
+	open    int                // sessions open or being opened
+	closed  bool
+	changed chan struct{} // closed and replaced when a session becomes idle
+	done    chan struct{} // closed with the pool
+}
+
+func (p *Pool) size() int {
+	if p.Size > 0 {
+		return p.Size
+	}
+	return len(p.LUs)
+}
+
+func durationOrDefault(d time.Duration, def time.Duration) time.Duration {
+	if d == 0 {
+		return def
+	}
+	return d
+}
+
+func (p *Pool) start() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lus = make(map[*Client]string)
+	p.busy = make(map[*Client]bool)
+	p.free = append([]string(nil), p.LUs...)
+	p.changed = make(chan struct{})
+	p.done = make(chan struct{})
+	p.grow()
+	go p.check()
+}
+
+// grow opens the missing sessions, p.mu must be held
+func (p *Pool) grow() {
+	for !p.closed && p.open < p.size() {
+		lu := ""
+		if len(p.LUs) > 0 {
+			if len(p.free) == 0 {
+				return
+			}
+			lu, p.free = p.free[0], p.free[1:]
+		}
+		p.open++
+		go p.create(lu)
+	}
+}
+
+// signal wakes up the callers waiting for a session, p.mu must be held
+func (p *Pool) signal() {
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+func (p *Pool) create(lu string) {
+	c, err := p.connect(lu)
+	if err != nil {
+		logger := p.Logger
+		if logger == nil {
+			logger = stdLogger{}
+		}
+		logger.Printf("tn3270: pool session %s: %v", lu, err)
+		select {
+		case <-time.After(durationOrDefault(p.RetryDelay, 5*time.Second)):
+		case <-p.done:
+		}
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.open--
+		p.releaseLU(lu)
+		p.grow()
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lus[c] = lu
+	if p.closed {
+		p.drop(c)
+		return
+	}
+	p.idle = append(p.idle, c)
+	p.signal()
+}
+
+// dial calls Dial, giving up once timeout is done. The connection is then
+// closed when Dial returns.
+func (p *Pool) dial(timeout <-chan time.Time) (net.Conn, error) {
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	dialed := make(chan result, 1)
+	go func() {
+		conn, err := p.Dial()
+		dialed <- result{conn, err}
+	}()
+	select {
+	case r := <-dialed:
+		return r.conn, r.err
+	case <-timeout:
+		go func() {
+			if r := <-dialed; r.conn != nil {
+				r.conn.Close()
+			}
+		}()
+		return nil, ErrNegotiationTimeout
+	}
+}
+
+// connect opens and logs on a session, opening the connection and getting
+// the welcome screen take at most Timeout
+func (p *Pool) connect(lu string) (*Client, error) {
+	timeout := durationOrDefault(p.Timeout, 30*time.Second)
+	deadline := time.Now().Add(timeout)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	c := NewClient(lu)
+	c.CodePage = p.CodePage
+	c.Timeout = timeout
+	var recv chan string
+	var err error
+	switch {
+	case p.Dial != nil:
+		var conn net.Conn
+		if conn, err = p.dial(timer.C); err == nil {
+			if c.Timeout = time.Until(deadline); c.Timeout <= 0 {
+				c.Timeout = time.Nanosecond
+			}
+			recv, err = c.ConnectConn(conn)
+		}
+	case p.TLSConfig != nil:
+		recv, err = c.ConnectTLS(p.Addr, p.TLSConfig)
+	default:
+		recv, err = c.Connect(p.Addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var screen string
+	select {
+	case screen = <-recv:
+	case <-c.Done():
+		err = ErrNoWelcomeScreen
+	case <-timer.C:
+		err = ErrNoWelcomeScreen
+	}
+	if err == nil && p.Logon != nil {
+		err = p.Logon(c, screen)
+	}
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+func (p *Pool) releaseLU(lu string) {
+	if len(p.LUs) > 0 {
+		p.free = append(p.free, lu)
+	}
+}
+
+// drop closes a session and replaces it, p.mu must be held
+func (p *Pool) drop(c *Client) {
+	c.Close()
+	p.releaseLU(p.lus[c])
+	delete(p.lus, c)
+	delete(p.busy, c)
+	p.open--
+	p.grow()
+}
+
+func disconnected(c *Client) bool {
+	select {
+	case <-c.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// check runs the health checks of the idle sessions until the pool is
+// closed
+func (p *Pool) check() {
+	ticker := time.NewTicker(durationOrDefault(p.HealthCheckInterval, time.Minute))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-p.done:
+			return
+		}
+		p.mu.Lock()
+		idle := p.idle
+		p.idle = nil
+		p.mu.Unlock()
+		for _, c := range idle {
+			healthy := !disconnected(c) && (p.HealthCheck == nil || p.HealthCheck(c) == nil)
+			p.mu.Lock()
+			if healthy && !p.closed {
+				p.idle = append(p.idle, c)
+				p.signal()
+			} else {
+				p.drop(c)
+			}
+			p.mu.Unlock()
+		}
+	}
+}
+
+// Acquire returns an idle session, waiting for one until ctx is done. The
+// session must be given back with Release.
+func (p *Pool) Acquire(ctx context.Context) (*Client, error) {
+	p.once.Do(p.start)
+	if p.size() == 0 {
+		return nil, ErrPoolEmpty
+	}
+	for {
+		p.mu.Lock()
+		if p.closed {
+			p.mu.Unlock()
+			return nil, ErrPoolClosed
+		}
+		for len(p.idle) > 0 {
+			c := p.idle[0]
+			p.idle = p.idle[1:]
+			if disconnected(c) {
+				p.drop(c)
+				continue
+			}
+			p.busy[c] = true
+			p.mu.Unlock()
+			return c, nil
+		}
+		changed := p.changed
+		p.mu.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// Release gives a session back to the pool. Sessions that lost their
+// connection are replaced. Sessions that are not acquired, for instance
+// already released, are ignored.
+func (p *Pool) Release(c *Client) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.busy[c] {
+		return
+	}
+	delete(p.busy, c)
+	if p.closed || disconnected(c) {
+		p.drop(c)
+		return
+	}
+	p.idle = append(p.idle, c)
+	p.signal()
+}
+
+// Discard closes a session acquired from the pool instead of releasing it,
+// for instance when it is left on an unexpected screen, and replaces it
+func (p *Pool) Discard(c *Client) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.busy[c] {
+		p.drop(c)
+	}
+}
+
+// Idle returns the number of sessions ready to be acquired
+func (p *Pool) Idle() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.idle)
+}
+
+// Close closes the idle sessions, the acquired ones are closed when they
+// are released. Acquire fails with ErrPoolClosed afterwards.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+	p.once.Do(p.start)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	select {
+	case <-p.done:
+		return nil
+	default:
+	}
+	close(p.done)
+	for _, c := range p.idle {
+		p.drop(c)
+	}
+	p.idle = nil
+	p.signal()
+	return nil
+}
@@ -0,0 +1,194 @@
+package tn3270_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+var _ = Describe("Pool", func() {
+	var server *tn3270.Server
+	var pool *tn3270.Pool
+	var mu sync.Mutex
+	var logons map[string]int
+
+	logonCount := func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		n := 0
+		for _, c := range logons {
+			n += c
+		}
+		return n
+	}
+
+	BeforeEach(func() {
+		logons = make(map[string]int)
+		server = &tn3270.Server{Handler: tn3270.HandlerFunc(func(w tn3270.ResponseWriter, r *tn3270.Request) {
+			if r.Text == "LOGON" {
+				mu.Lock()
+				logons[r.LUName]++
+				mu.Unlock()
+				io.WriteString(w, "READY")
+				return
+			}
+			io.WriteString(w, "ECHO: "+r.Text)
+		})}
+		lus, err := tn3270.LURange("LU01", "LU02")
+		Expect(err).To(Succeed())
+		pool = &tn3270.Pool{
+			Dial: func() (net.Conn, error) {
+				client, host := net.Pipe()
+				go server.ServeConn(host)
+				return client, nil
+			},
+			LUs: lus,
+			Logon: func(c *tn3270.Client, screen string) error {
+				if c.SendRecv("LOGON") != "READY" {
+					return errors.New("logon failed")
+				}
+				return nil
+			},
+			RetryDelay: 10 * time.Millisecond,
+		}
+	})
+
+	AfterEach(func() {
+		pool.Close()
+		server.Close()
+	})
+
+	It("Should hand out logged on sessions", func() {
+		ctx := context.Background()
+		c1, err := pool.Acquire(ctx)
+		Expect(err).To(Succeed())
+		c2, err := pool.Acquire(ctx)
+		Expect(err).To(Succeed())
+		Expect(c1).NotTo(BeIdenticalTo(c2))
+		Expect(c1.SendRecv("PING")).To(Equal("ECHO: PING"))
+
+		mu.Lock()
+		Expect(logons).To(Equal(map[string]int{"LU01": 1, "LU02": 1}))
+		mu.Unlock()
+
+		timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = pool.Acquire(timeout)
+		Expect(err).To(Equal(context.DeadlineExceeded))
+
+		pool.Release(c1)
+		c3, err := pool.Acquire(ctx)
+		Expect(err).To(Succeed())
+		Expect(c3).To(BeIdenticalTo(c1))
+		pool.Release(c2)
+		pool.Release(c3)
+		Expect(pool.Idle()).To(Equal(2))
+		pool.Release(c3)
+		Expect(pool.Idle()).To(Equal(2))
+	})
+
+	It("Should fail without sessions", func() {
+		empty := &tn3270.Pool{Dial: pool.Dial}
+		defer empty.Close()
+		_, err := empty.Acquire(context.Background())
+		Expect(err).To(Equal(tn3270.ErrPoolEmpty))
+	})
+
+	It("Should replace broken and discarded sessions", func() {
+		c, err := pool.Acquire(context.Background())
+		Expect(err).To(Succeed())
+		c.Close()
+		<-c.Done()
+		pool.Release(c)
+		Eventually(pool.Idle).Should(Equal(2))
+
+		c, err = pool.Acquire(context.Background())
+		Expect(err).To(Succeed())
+		pool.Discard(c)
+		Eventually(pool.Idle).Should(Equal(2))
+		Expect(logonCount()).To(Equal(4))
+	})
+
+	It("Should replace the sessions failing their health check", func() {
+		var once sync.Once
+		pool.HealthCheckInterval = 10 * time.Millisecond
+		pool.HealthCheck = func(c *tn3270.Client) error {
+			var err error
+			once.Do(func() { err = errors.New("unhealthy") })
+			return err
+		}
+		_, err := pool.Acquire(context.Background())
+		Expect(err).To(Succeed())
+		Eventually(logonCount).Should(Equal(3))
+	})
+
+	It("Should retry sessions failing to log on", func() {
+		var failures int
+		logon := pool.Logon
+		pool.Logon = func(c *tn3270.Client, screen string) error {
+			mu.Lock()
+			failures++
+			fail := failures <= 2
+			mu.Unlock()
+			if fail {
+				return errors.New("host not ready")
+			}
+			return logon(c, screen)
+		}
+		pool.Logger = tn3270.TraceWriter(GinkgoWriter)
+		_, err := pool.Acquire(context.Background())
+		Expect(err).To(Succeed())
+		Eventually(logonCount).Should(Equal(2))
+	})
+
+	It("Should give up on connections not ready in time", func() {
+		stuck := make(chan struct{})
+		defer close(stuck)
+		var dials int
+		dial := pool.Dial
+		pool.Dial = func() (net.Conn, error) {
+			mu.Lock()
+			dials++
+			n := dials
+			mu.Unlock()
+			switch n {
+			case 1:
+				<-stuck
+				return nil, errors.New("unreachable")
+			case 2:
+				client, host := net.Pipe()
+				go io.Copy(ioutil.Discard, host)
+				return client, nil
+			}
+			return dial()
+		}
+		pool.Timeout = 50 * time.Millisecond
+		pool.Logger = tn3270.TraceWriter(GinkgoWriter)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		c1, err := pool.Acquire(ctx)
+		Expect(err).To(Succeed())
+		c2, err := pool.Acquire(ctx)
+		Expect(err).To(Succeed())
+		Expect(c1).NotTo(BeIdenticalTo(c2))
+	})
+
+	It("Should fail once closed", func() {
+		c, err := pool.Acquire(context.Background())
+		Expect(err).To(Succeed())
+		pool.Close()
+		_, err = pool.Acquire(context.Background())
+		Expect(err).To(Equal(tn3270.ErrPoolClosed))
+		pool.Release(c)
+		Eventually(c.Done()).Should(BeClosed())
+	})
+})
@@ -0,0 +1,316 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"crypto/tls"
+	"io"
+	"log"
+	"net"
+	"sync"
+)
+
+// Printer emulates a 3287 printer session. It negotiates an IBM-3287-1
+// device, prints the SCS-DATA records and the LU3 3270 data stream records
+// of the host, and hands each job to the Sink at PRINT-EOJ and when the
+// session ends.
+type Printer struct {
+	CodePage *CodePage // code page of the host, CP037 if nil
+	Sink     PrintSink // receives the print jobs
+	Trace    Logger    // logs the decoded protocol events if not nil
+
+	// Options handle the telnet options the printer does not support,
+	// they are refused otherwise. TN3270E and TERMINAL-TYPE cannot be
+	// overridden.
+	Options map[byte]OptionHandler
+
+	// Associate is the device name of the terminal session the printer is
+	// requested for, the LU name is ignored if set
+	Associate string
+
+	// mu protects the state below, it is held while the parser runs
+	mu         sync.Mutex
+	conn       net.Conn
+	luname     string // requested LU, generic if empty
+	negotiated string // LU assigned by DEVICE-TYPE IS
+	functions  Functions
+	parser     Parser
+	telnet     *negotiator
+	layout     *pageLayout
+	buffer     *lu3Buffer
+	seq        uint16      // sequence number of the record being handled
+	respond    bool        // the host wants a response to the record
+	out        chan []byte // see send
+	done       chan struct{}
+	ready      chan struct{}
+	readyErr   error
+}
+
+// NewPrinter returns a printer requesting the given LU, or a generic LU if
+// luname is empty
+func NewPrinter(luname string, sink PrintSink) *Printer {
+	p := &Printer{luname: luname, Sink: sink}
+	p.buffer = &lu3Buffer{VirtualScreenTN3270Handler: NewVirtualScreenTN3270Handler(24, 80), p: p}
+	p.parser = NewParser(p, p, p.buffer, p)
+	p.out = make(chan []byte, 16)
+	p.done = make(chan struct{})
+	p.ready = make(chan struct{})
+	return p
+}
+
+// printerFunctions are the TN3270E functions supported by printers
+var printerFunctions = Functions{FunctionDataStreamCtl, FunctionResponses, FunctionSCSCtlCodes}
+
+// lu3Buffer is the buffer of the 3270 data stream records, printed when
+// their WCC says so
+type lu3Buffer struct {
+	*VirtualScreenTN3270Handler
+	p   *Printer
+	wcc byte
+}
+
+func (b *lu3Buffer) OnTN3270WCC(wcc byte) {
+	b.wcc = wcc
+	b.VirtualScreenTN3270Handler.OnTN3270WCC(wcc)
+}
+
+func (b *lu3Buffer) OnTN3270Message() {
+	if b.wcc&0x08 != 0 { // Start printer
+		lineLength := 0
+		switch b.wcc & 0x30 {
+		case 0x10:
+			lineLength = 40
+		case 0x20:
+			lineLength = 64
+		case 0x30:
+			lineLength = 80
+		}
+		b.p.layout.printBuffer(b.screen, lineLength)
+	}
+	b.wcc = 0
+	b.p.acknowledge()
+}
+
+func (p *Printer) recv(conn io.Reader) {
+	defer close(p.done)
+	defer func() {
+		p.mu.Lock()
+		p.endJob()
+		p.negotiationDone(ErrConnectionLost)
+		p.mu.Unlock()
+	}()
+	buf := make([]byte, 2048)
+	for {
+		n, err := conn.Read(buf)
+		if n > 0 {
+			p.mu.Lock()
+			if err := p.parser.Parse(buf[:n]); err != nil {
+				log.Printf("ERROR: %s", err)
+			}
+			p.mu.Unlock()
+		}
+		if err != nil {
+			break
+		}
+	}
+}
+
+// negotiationDone ends the negotiation, p.mu must be held
+func (p *Printer) negotiationDone(err error) {
+	select {
+	case <-p.ready:
+	default:
+		p.readyErr = err
+		close(p.ready)
+	}
+}
+
+// handle runs the session over conn and waits for the negotiation of the
+// device type
+func (p *Printer) handle(conn net.Conn) error {
+	p.layout = newPageLayout(codePageOrDefault(p.CodePage))
+	p.buffer.CodePage = p.CodePage
+	if p.Trace != nil {
+		conn = newTraceConn(conn, p.Trace, "", p.CodePage, false)
+	}
+	p.mu.Lock()
+	p.conn = conn
+	p.telnet = newNegotiator(p.write, map[byte]OptionHandler{
+		OptionTN3270E:      {Local: true},
+		OptionTerminalType: terminalTypeHandler(func() string { return "IBM-3287-1" }),
+	}, p.Options)
+	p.mu.Unlock()
+	go p.recv(conn)
+	go send(conn, p.out, p.done)
+	<-p.ready
+	if p.readyErr != nil {
+		conn.Close()
+		return p.readyErr
+	}
+	return nil
+}
+
+// Connect opens a printer session to the host. A *NegotiationError is
+// returned if the host rejects the LU.
+func (p *Printer) Connect(addr string) error {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return p.handle(conn)
+}
+
+func (p *Printer) ConnectTLS(addr string, config *tls.Config) error {
+	conn, err := tls.Dial("tcp", addr, config)
+	if err != nil {
+		return err
+	}
+	return p.handle(conn)
+}
+
+// ConnectConn runs the session over an established connection, such as one
+// end of a net.Pipe
+func (p *Printer) ConnectConn(conn net.Conn) error {
+	return p.handle(conn)
+}
+
+// Close closes the connection to the host, the job in progress is handed
+// to the sink
+func (p *Printer) Close() error {
+	p.mu.Lock()
+	conn := p.conn
+	p.mu.Unlock()
+	if conn == nil {
+		return nil
+	}
+	return conn.Close()
+}
+
+// Done returns a channel closed when the session ended
+func (p *Printer) Done() <-chan struct{} {
+	return p.done
+}
+
+// LUName returns the LU assigned by the host, empty until the device type
+// is negotiated
+func (p *Printer) LUName() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.negotiated
+}
+
+// NegotiatedFunctions returns the TN3270E functions agreed with the host
+func (p *Printer) NegotiatedFunctions() Functions {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.functions
+}
+
+// write sends data to the host, p.mu must be held
+func (p *Printer) write(data []byte) {
+	select {
+	case p.out <- data:
+	case <-p.done:
+	}
+}
+
+// acknowledge sends a positive response to the record just handled if the
+// host asked for one
+func (p *Printer) acknowledge() {
+	if p.respond {
+		p.respond = false
+		p.write(positiveResponse(p.seq))
+	}
+}
+
+// endJob hands the pages printed so far to the sink
+func (p *Printer) endJob() {
+	if p.layout == nil || p.layout.empty() {
+		return
+	}
+	job := &PrintJob{LUName: p.negotiated, Pages: p.layout.job()}
+	if p.Sink == nil {
+		return
+	}
+	if err := p.Sink.Print(job); err != nil {
+		log.Printf("tn3270: printing job of %s: %v", job.LUName, err)
+	}
+}
+
+func (p *Printer) OnTNCommand(byte) {
+}
+
+func (p *Printer) OnTNArgCommand(b byte, arg byte) {
+	p.telnet.receive(b, arg)
+}
+
+func (p *Printer) OnTNSubnegotiation(option byte, data []byte) bool {
+	return p.telnet.subnegotiation(option, data)
+}
+
+func (p *Printer) OnError([]byte, int) error {
+	return nil
+}
+
+func (p *Printer) OnTN3270DeviceTypeRequest([]byte, []byte, []byte) {
+}
+
+func (p *Printer) OnTN3270DeviceTypeIs(deviceType []byte, name []byte) {
+	p.negotiated = string(name)
+	p.negotiationDone(nil)
+	p.write(functionsRequest(printerFunctions))
+}
+
+func (p *Printer) OnTN3270DeviceTypeReject(reason byte) {
+	lu := p.luname
+	if p.Associate != "" {
+		lu = p.Associate
+	}
+	p.negotiationDone(&NegotiationError{LU: lu, Reason: ReasonCode(reason)})
+}
+
+func (p *Printer) OnTN3270FunctionsIs(functions []byte) {
+	p.functions = parseFunctions(functions)
+}
+
+func (p *Printer) OnTN3270FunctionsRequest(functions []byte) {
+	agreed, answer := answerFunctions(parseFunctions(functions), printerFunctions)
+	if agreed != nil {
+		p.functions = agreed
+	}
+	p.write(answer)
+}
+
+func (p *Printer) OnTN3270SendDeviceType() {
+	connect := p.luname
+	if p.Associate != "" {
+		connect = ""
+	}
+	p.write(deviceTypeRequest("IBM-3287-1", p.Associate, connect))
+}
+
+func (p *Printer) OnTN3270Header(dataType byte, responseFlag byte, seq uint16) {
+	p.seq = seq
+	p.respond = responseFlag == responseFlagAlways
+}
+
+func (p *Printer) OnTN3270SCS(data []byte) {
+	p.layout.printSCS(data)
+	p.acknowledge()
+}
+
+func (p *Printer) OnTN3270PrintEOJ() {
+	p.endJob()
+}
@@ -0,0 +1,306 @@
+package tn3270_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+var _ = Describe("Printer", func() {
+	var host net.Conn
+	var printer *tn3270.Printer
+	var jobs chan *tn3270.PrintJob
+	var mu sync.Mutex
+	var received bytes.Buffer
+
+	ebcdic := func(s string) []byte {
+		return tn3270.CP037.Encode(s)
+	}
+
+	record := func(header []byte, data ...[]byte) []byte {
+		r := append([]byte(nil), header...)
+		for _, d := range data {
+			r = append(r, d...)
+		}
+		return append(r, 0xff, 0xef)
+	}
+
+	output := func() []byte {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]byte(nil), received.Bytes()...)
+	}
+
+	BeforeEach(func() {
+		mu.Lock()
+		received.Reset()
+		mu.Unlock()
+		jobs = make(chan *tn3270.PrintJob, 4)
+		printer = tn3270.NewPrinter("PRT01", tn3270.PrintSinkFunc(func(job *tn3270.PrintJob) error {
+			jobs <- job
+			return nil
+		}))
+		var conn net.Conn
+		conn, host = net.Pipe()
+		go func() {
+			buf := make([]byte, 1024)
+			for {
+				n, err := host.Read(buf)
+				mu.Lock()
+				received.Write(buf[:n])
+				mu.Unlock()
+				if err != nil {
+					return
+				}
+			}
+		}()
+		go func() {
+			host.Write([]byte{0xff, 0xfd, 0x28})
+			host.Write([]byte{0xff, 0xfa, 0x28, 0x08, 0x02, 0xff, 0xf0})
+			host.Write(append(append([]byte{0xff, 0xfa, 0x28, 0x02, 0x04}, "IBM-3287-1\x01PRT01"...), 0xff, 0xf0))
+		}()
+		Expect(printer.ConnectConn(conn)).To(Succeed())
+		Expect(printer.LUName()).To(Equal("PRT01"))
+		host.Write([]byte{0xff, 0xfa, 0x28, 0x03, 0x04, 0x01, 0x02, 0x03, 0xff, 0xf0})
+		Eventually(printer.NegotiatedFunctions).Should(Equal(tn3270.Functions{
+			tn3270.FunctionDataStreamCtl, tn3270.FunctionResponses, tn3270.FunctionSCSCtlCodes,
+		}))
+	})
+
+	AfterEach(func() {
+		host.Close()
+		Eventually(printer.Done()).Should(BeClosed())
+	})
+
+	It("Should request an IBM-3287-1 device", func() {
+		Eventually(output).Should(ContainSubstring("\xff\xfb\x28"))
+		Eventually(output).Should(ContainSubstring("\xff\xfa\x28\x02\x07IBM-3287-1\x01PRT01\xff\xf0"))
+	})
+
+	It("Should print SCS data and acknowledge the records", func() {
+		host.Write(record([]byte{0x01, 0x00, 0x02, 0x00, 0x01},
+			ebcdic("HELLO"), []byte{0x15}, ebcdic("WORLD"), []byte{0x0c},
+			ebcdic("A"), []byte{0x05}, ebcdic("B"), []byte{0x35, 0x02}, ebcdic("CD"),
+		))
+		host.Write(record([]byte{0x08, 0x00, 0x00, 0x00, 0x02}))
+		var job *tn3270.PrintJob
+		Eventually(jobs).Should(Receive(&job))
+		Expect(job.LUName).To(Equal("PRT01"))
+		Expect(job.Pages).To(Equal([]string{"HELLO\nWORLD", "A       BCD"}))
+		Expect(job.Text()).To(Equal("HELLO\nWORLD\fA       BCD"))
+		Eventually(output).Should(ContainSubstring("\x02\x00\x00\x00\x01\x00\xff\xef"))
+	})
+
+	It("Should honor the horizontal format of SCS data", func() {
+		host.Write(record([]byte{0x01, 0x00, 0x00, 0x00, 0x01},
+			[]byte{0x2b, 0xc1, 0x03, 0x0a, 0x03}, ebcdic("ABCDEFGHIJ"),
+		))
+		host.Write(record([]byte{0x08, 0x00, 0x00, 0x00, 0x02}))
+		var job *tn3270.PrintJob
+		Eventually(jobs).Should(Receive(&job))
+		Expect(job.Pages).To(Equal([]string{"  ABCDEFGH\n  IJ"}))
+	})
+
+	It("Should go to the next page for vertical tabs below the page", func() {
+		host.Write(record([]byte{0x01, 0x00, 0x00, 0x00, 0x01},
+			[]byte{0x2b, 0xc2, 0x05, 0x05, 0x01, 0x00, 0x0a}, ebcdic("A"), []byte{0x0b}, ebcdic("B"),
+		))
+		host.Write(record([]byte{0x08, 0x00, 0x00, 0x00, 0x02}))
+		var job *tn3270.PrintJob
+		Eventually(jobs).Should(Receive(&job))
+		Expect(job.Pages).To(Equal([]string{"A", " B"}))
+	})
+
+	It("Should print the LU3 buffer when the WCC starts the printer", func() {
+		// Erase/Write, WCC with start printer and 80 characters lines
+		host.Write(record([]byte{0x00, 0x00, 0x00, 0x00, 0x01},
+			[]byte{0xf5, 0x38}, ebcdic("REPORT"), []byte{0x11, 0xc2, 0x60}, ebcdic("LINE 3"),
+		))
+		// Write without start printer
+		host.Write(record([]byte{0x00, 0x00, 0x00, 0x00, 0x02}, []byte{0xf1, 0x00}, ebcdic("IGNORED")))
+		host.Write(record([]byte{0x08, 0x00, 0x00, 0x00, 0x03}))
+		var job *tn3270.PrintJob
+		Eventually(jobs).Should(Receive(&job))
+		Expect(job.Pages).To(Equal([]string{"REPORT\n\nLINE 3"}))
+	})
+
+	It("Should print unformatted LU3 buffers up to EM", func() {
+		host.Write(record([]byte{0x00, 0x00, 0x00, 0x00, 0x01},
+			[]byte{0xf5, 0x08}, ebcdic("ONE"), []byte{0x15}, ebcdic("TWO"), []byte{0x19}, ebcdic("THREE"),
+		))
+		host.Write(record([]byte{0x08, 0x00, 0x00, 0x00, 0x02}))
+		var job *tn3270.PrintJob
+		Eventually(jobs).Should(Receive(&job))
+		Expect(job.Pages).To(Equal([]string{"ONE\nTWO"}))
+	})
+
+	It("Should hand the last job to the sink when the session ends", func() {
+		host.Write(record([]byte{0x01, 0x00, 0x00, 0x00, 0x01}, ebcdic("UNFINISHED")))
+		host.Close()
+		var job *tn3270.PrintJob
+		Eventually(jobs).Should(Receive(&job))
+		Expect(job.Pages).To(Equal([]string{"UNFINISHED"}))
+	})
+})
+
+var _ = Describe("Print sinks", func() {
+	job := &tn3270.PrintJob{LUName: "PRT01", Pages: []string{"PAGE (1)", "PAGE 2"}}
+
+	It("Should write the jobs to a writer", func() {
+		var buf bytes.Buffer
+		Expect(tn3270.WriterSink(&buf).Print(job)).To(Succeed())
+		Expect(buf.String()).To(Equal("PAGE (1)\fPAGE 2\f"))
+	})
+
+	It("Should write the jobs to text and PDF files", func() {
+		dir, err := ioutil.TempDir("", "tn3270")
+		Expect(err).To(Succeed())
+		defer os.RemoveAll(dir)
+		Expect(tn3270.TextFileSink(dir).Print(job)).To(Succeed())
+		Expect(tn3270.PDFFileSink(dir).Print(job)).To(Succeed())
+
+		texts, _ := filepath.Glob(filepath.Join(dir, "PRT01-*.txt"))
+		Expect(texts).To(HaveLen(1))
+		text, err := ioutil.ReadFile(texts[0])
+		Expect(err).To(Succeed())
+		Expect(string(text)).To(Equal(job.Text()))
+
+		pdfs, _ := filepath.Glob(filepath.Join(dir, "PRT01-*.pdf"))
+		Expect(pdfs).To(HaveLen(1))
+		pdf, err := ioutil.ReadFile(pdfs[0])
+		Expect(err).To(Succeed())
+		Expect(string(pdf)).To(HavePrefix("%PDF-1.4\n"))
+		Expect(string(pdf)).To(ContainSubstring("/Count 2"))
+		Expect(string(pdf)).To(ContainSubstring("(PAGE \\(1\\)) Tj"))
+		Expect(string(pdf)).To(HaveSuffix("%%EOF\n"))
+	})
+})
+
+// welcomeCounter counts the welcome screens served by its handler
+type welcomeCounter struct {
+	tn3270.Handler
+	welcomes *int32
+}
+
+func (h welcomeCounter) ServeWelcomeScreen(w tn3270.ResponseWriter) {
+	atomic.AddInt32(h.welcomes, 1)
+	h.Handler.ServeWelcomeScreen(w)
+}
+
+var _ = Describe("Server printers", func() {
+	var server *tn3270.Server
+	var jobs chan *tn3270.PrintJob
+
+	connect := func(luname, associate string) *tn3270.Printer {
+		printer := tn3270.NewPrinter(luname, tn3270.PrintSinkFunc(func(job *tn3270.PrintJob) error {
+			jobs <- job
+			return nil
+		}))
+		printer.Associate = associate
+		conn, host := net.Pipe()
+		go server.ServeConn(host)
+		Expect(printer.ConnectConn(conn)).To(Succeed())
+		Eventually(printer.NegotiatedFunctions).Should(HaveLen(3))
+		return printer
+	}
+
+	BeforeEach(func() {
+		jobs = make(chan *tn3270.PrintJob, 4)
+		pool := tn3270.NewLUPool()
+		Expect(pool.AddRange("TERM01", "TERM02")).To(Succeed())
+		pool.AddPrinter("TERM01", "PRT01")
+		pool.AddPrinter("TERM02", "PRT02")
+		server = &tn3270.Server{LUPool: pool, Handler: tn3270.HandlerFunc(func(w tn3270.ResponseWriter, r *tn3270.Request) {
+			printer, err := r.Printer()
+			if err != nil {
+				w.Write([]byte(err.Error()))
+				return
+			}
+			switch r.Text {
+			case "REPORT":
+				err = printer.Print(&tn3270.PrintJob{Pages: []string{"REPORT OF " + r.LUName + "\nLINE 2", "PAGE 2"}})
+			case "LU3":
+				// Erase/Write with start printer, unformatted
+				data := append([]byte{0xf5, 0x08}, tn3270.CP037.Encode("LU3 REPORT")...)
+				if err = printer.Write3270(data); err == nil {
+					err = printer.EndJob()
+				}
+			}
+			if err != nil {
+				w.Write([]byte(err.Error()))
+				return
+			}
+			w.Write([]byte("PRINTED ON " + printer.LUName()))
+		})}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	connectTerminal := func() *tn3270.Client {
+		client := tn3270.NewClient("")
+		conn, host := net.Pipe()
+		go server.ServeConn(host)
+		recv, err := client.ConnectConn(conn)
+		Expect(err).To(Succeed())
+		<-recv
+		return client
+	}
+
+	It("Should send jobs to the printer associated with the terminal", func() {
+		terminal := connectTerminal()
+		printer := connect("", terminal.LUName())
+		Expect(printer.LUName()).To(Equal("PRT01"))
+
+		Expect(terminal.SendRecv("REPORT")).To(Equal("PRINTED ON PRT01"))
+		var job *tn3270.PrintJob
+		Eventually(jobs).Should(Receive(&job))
+		Expect(job.LUName).To(Equal("PRT01"))
+		Expect(job.Pages).To(Equal([]string{"REPORT OF TERM01\nLINE 2", "PAGE 2"}))
+
+		Expect(terminal.SendRecv("LU3")).To(Equal("PRINTED ON PRT01"))
+		Eventually(jobs).Should(Receive(&job))
+		Expect(job.Pages).To(Equal([]string{"LU3 REPORT"}))
+	})
+
+	It("Should not serve the welcome screen to printers", func() {
+		var welcomes int32
+		server.Handler = welcomeCounter{server.Handler, &welcomes}
+		terminal := connectTerminal()
+		connect("", terminal.LUName())
+		Expect(terminal.SendRecv("REPORT")).To(Equal("PRINTED ON PRT01"))
+		Eventually(jobs).Should(Receive())
+		Expect(atomic.LoadInt32(&welcomes)).To(Equal(int32(1)))
+	})
+
+	It("Should find the printers tied to the terminal by the pool", func() {
+		connectTerminal()
+		terminal := connectTerminal()
+		connect("PRT02", "")
+		Expect(terminal.SendRecv("REPORT")).To(Equal("PRINTED ON PRT02"))
+		var job *tn3270.PrintJob
+		Eventually(jobs).Should(Receive(&job))
+		Expect(job.Pages[0]).To(HavePrefix("REPORT OF TERM02"))
+	})
+
+	It("Should fail without printer", func() {
+		terminal := connectTerminal()
+		Expect(terminal.SendRecv("REPORT")).To(Equal(tn3270.ErrNoPrinter.Error()))
+		printer := connect("", terminal.LUName())
+		printer.Close()
+		Eventually(printer.Done()).Should(BeClosed())
+		Eventually(func() string {
+			return terminal.SendRecv("REPORT")
+		}).Should(Equal(tn3270.ErrNoPrinter.Error()))
+	})
+})
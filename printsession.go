@@ -0,0 +1,131 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNoPrinter is returned when no printer session is associated with a
+// terminal
+var ErrNoPrinter = errors.New("tn3270: no printer session associated")
+
+// PrinterSession sends print jobs to a printer connected to the Server. A
+// job is made of any number of SCS or 3270 records, ended by EndJob.
+type PrinterSession struct {
+	c *conn
+}
+
+// LUName returns the LU of the printer
+func (p *PrinterSession) LUName() string {
+	return p.c.luname
+}
+
+// Functions returns the TN3270E functions negotiated with the printer
+func (p *PrinterSession) Functions() Functions {
+	return p.c.functions
+}
+
+// WriteSCS sends SNA character string data, SCS-CTL-CODES must have been
+// negotiated
+func (p *PrinterSession) WriteSCS(data []byte) error {
+	if !p.c.functions.Has(FunctionSCSCtlCodes) {
+		return fmt.Errorf("tn3270: %s not negotiated with %s", FunctionSCSCtlCodes, p.c.luname)
+	}
+	return p.send(dataTypeSCS, data)
+}
+
+// Write3270 sends a 3270 data stream record, starting with the command and
+// the WCC. The WCC must start the printer for the buffer to be printed.
+func (p *PrinterSession) Write3270(data []byte) error {
+	return p.send(dataType3270, data)
+}
+
+// EndJob tells the printer that the job is complete, DATA-STREAM-CTL must
+// have been negotiated
+func (p *PrinterSession) EndJob() error {
+	if !p.c.functions.Has(FunctionDataStreamCtl) {
+		return fmt.Errorf("tn3270: %s not negotiated with %s", FunctionDataStreamCtl, p.c.luname)
+	}
+	return p.send(dataTypePrintEOJ, nil)
+}
+
+// Print sends the pages of a job as SCS data followed by the end of the
+// job, lines are separated by new lines and pages by form feeds
+func (p *PrinterSession) Print(job *PrintJob) error {
+	cp := codePageOrDefault(p.c.server.CodePage)
+	var data []byte
+	for i, page := range job.Pages {
+		if i > 0 {
+			data = append(data, scsFF)
+		}
+		for j, line := range strings.Split(page, "\n") {
+			if j > 0 {
+				data = append(data, scsNL)
+			}
+			data = append(data, cp.Encode(line)...)
+		}
+	}
+	if err := p.WriteSCS(data); err != nil {
+		return err
+	}
+	return p.EndJob()
+}
+
+func (p *PrinterSession) send(dataType byte, data []byte) error {
+	return p.c.write([]byte{dataType, 0x00, 0x00, 0x00, 0x00}, escapeIAC(data), []byte{0xff, 0xef})
+}
+
+// isPrinter reports whether the connection negotiated a printer device
+func (c *conn) isPrinter() bool {
+	return c.deviceType == "IBM-3287-1"
+}
+
+// trackPrinter makes a printer available to handlers once negotiated
+func (s *Server) trackPrinter(c *conn, ready bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.printers == nil {
+		s.printers = make(map[*conn]struct{})
+	}
+	if ready {
+		s.printers[c] = struct{}{}
+	} else {
+		delete(s.printers, c)
+	}
+}
+
+// Printer returns the printer session associated with the terminal LU,
+// either by an ASSOCIATE request or by the LUPool. ErrNoPrinter is returned
+// if no such printer is connected.
+func (s *Server) Printer(terminal string) (*PrinterSession, error) {
+	if terminal == "" {
+		return nil, ErrNoPrinter
+	}
+	var printer string
+	if s.LUPool != nil {
+		printer, _ = s.LUPool.Printer(terminal)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for c := range s.printers {
+		if c.associate == terminal || printer != "" && c.luname == printer {
+			return &PrinterSession{c: c}, nil
+		}
+	}
+	return nil, ErrNoPrinter
+}
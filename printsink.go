@@ -0,0 +1,95 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PrintJob is the output of a printer session between two ends of job
+type PrintJob struct {
+	LUName string   // LU of the printer session
+	Pages  []string // text of each page, lines are separated by \n
+}
+
+// Text returns the text of the job, pages are separated by form feeds
+func (j *PrintJob) Text() string {
+	return strings.Join(j.Pages, "\f")
+}
+
+// PrintSink receives the jobs printed by a Printer
+type PrintSink interface {
+	Print(job *PrintJob) error
+}
+
+// PrintSinkFunc is an adapter to allow the use of ordinary functions as
+// print sinks
+type PrintSinkFunc func(job *PrintJob) error
+
+func (f PrintSinkFunc) Print(job *PrintJob) error {
+	return f(job)
+}
+
+// WriterSink writes the text of each job to w, followed by a form feed
+func WriterSink(w io.Writer) PrintSink {
+	var mu sync.Mutex
+	return PrintSinkFunc(func(job *PrintJob) error {
+		mu.Lock()
+		defer mu.Unlock()
+		_, err := io.WriteString(w, job.Text()+"\f")
+		return err
+	})
+}
+
+// TextFileSink writes each job to a new text file of dir
+func TextFileSink(dir string) PrintSink {
+	return fileSink(dir, ".txt", func(w io.Writer, job *PrintJob) error {
+		_, err := io.WriteString(w, job.Text())
+		return err
+	})
+}
+
+// PDFFileSink writes each job to a new PDF file of dir, see WritePDF
+func PDFFileSink(dir string) PrintSink {
+	return fileSink(dir, ".pdf", WritePDF)
+}
+
+// fileSink writes the jobs to files named after the LU, the time and a
+// sequence number
+func fileSink(dir string, ext string, write func(io.Writer, *PrintJob) error) PrintSink {
+	var mu sync.Mutex
+	n := 0
+	return PrintSinkFunc(func(job *PrintJob) error {
+		mu.Lock()
+		n++
+		name := fmt.Sprintf("%s-%s-%04d%s", job.LUName, time.Now().Format("20060102-150405"), n, ext)
+		mu.Unlock()
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		if err := write(f, job); err != nil {
+			f.Close()
+			return err
+		}
+		return f.Close()
+	})
+}
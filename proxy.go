@@ -0,0 +1,407 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"bytes"
+	"crypto/tls"
+	"log"
+	"net"
+	"runtime/debug"
+	"sync"
+)
+
+// Proxy accepts TN3270 clients and forwards their sessions to a backend
+// host. The telnet negotiation goes through untouched, the 3270 records are
+// decoded on their way so that hooks can observe or rewrite them.
+//
+// A Proxy listening with TLS in front of a plain backend terminates TLS for
+// hosts that do not support it.
+type Proxy struct {
+	Addr      string      // TCP address to listen on, ":telnet" if empty
+	Backend   string      // host:port of the backend host
+	TLSConfig *tls.Config // used by ServeTLS and ListenAndServeTLS
+	CodePage  *CodePage   // code page of the host, CP037 if nil
+
+	// BackendTLSConfig connects to the backend over TLS if not nil
+	BackendTLSConfig *tls.Config
+	// Dial opens the connection to the backend instead of dialing Backend
+	// if set
+	Dial func() (net.Conn, error)
+
+	// OnScreen is called with the 3270 data stream of each record sent by
+	// the host, without TN3270E header nor telnet escaping, once the
+	// screen of the session is updated. It returns the data forwarded to
+	// the client, nil to drop the record.
+	OnScreen func(s *ProxySession, data []byte) []byte
+	// OnRequest is called with each record sent by the client and the
+	// request it decodes to. It returns the data forwarded to the host,
+	// nil to drop the record.
+	OnRequest func(s *ProxySession, r *Request, data []byte) []byte
+
+	// Trace logs the decoded protocol events seen by the clients if not nil
+	Trace Logger
+
+	track tracker
+}
+
+// ProxySession is a client connection forwarded by a Proxy. The hooks of a
+// session are never called concurrently.
+type ProxySession struct {
+	RemoteAddr string  // network address of the client
+	Session    Session // state kept by the hooks across the records
+
+	proxy   *Proxy
+	client  net.Conn
+	backend net.Conn
+
+	mu        sync.Mutex // held while records are decoded and hooks run
+	tn3270e   bool       // records start with a TN3270E header
+	clientEOR bool       // the client sent WILL EOR
+	hostEOR   bool       // the host sent WILL EOR
+	luname    string
+	screen    *VirtualScreenTN3270Handler
+}
+
+// LUName returns the LU the host assigned to the session
+func (s *ProxySession) LUName() string {
+	return s.luname
+}
+
+// Screen returns the screen as displayed by the client, it may only be used
+// by the hooks
+func (s *ProxySession) Screen() *VirtualScreenTN3270Handler {
+	return s.screen
+}
+
+// proxyHandler tracks the negotiation of a proxied session
+type proxyHandler struct {
+	s *ProxySession
+}
+
+func (h *proxyHandler) OnTNCommand(byte) {
+}
+
+func (h *proxyHandler) OnTNArgCommand(c byte, a byte) {
+	if a == 0x28 && (c == 0xfb || c == 0xfc) { // WILL or WONT TN3270E
+		h.s.tn3270e = c == 0xfb
+	}
+}
+
+func (h *proxyHandler) OnTN3270DeviceTypeRequest([]byte, []byte, []byte) {
+}
+
+func (h *proxyHandler) OnTN3270DeviceTypeIs(deviceType []byte, deviceName []byte) {
+	h.s.luname = string(deviceName)
+}
+
+func (h *proxyHandler) OnTN3270DeviceTypeReject(byte) {
+}
+
+func (h *proxyHandler) OnTN3270FunctionsIs([]byte) {
+}
+
+func (h *proxyHandler) OnTN3270FunctionsRequest([]byte) {
+}
+
+func (h *proxyHandler) OnTN3270SendDeviceType() {
+}
+
+func (h *proxyHandler) OnError([]byte, int) error {
+	return nil
+}
+
+// requestDecoder decodes the records sent by the client of a proxied
+// session
+type requestDecoder struct {
+	requestBuilder
+}
+
+func (*requestDecoder) OnTN3270Command(byte) {}
+func (*requestDecoder) OnTN3270WCC(byte)     {}
+func (*requestDecoder) OnTN3270PT()          {}
+func (*requestDecoder) OnTN3270IC()          {}
+func (*requestDecoder) OnTN3270SF(byte)      {}
+func (*requestDecoder) OnTN3270SFE(byte)     {}
+func (*requestDecoder) OnTN3270RA(int, byte) {}
+func (*requestDecoder) OnTN3270EUA(int)      {}
+func (*requestDecoder) OnTN3270Message()     {}
+
+// negotiated tracks the options a side of the session agrees to enable
+func (s *ProxySession) negotiated(client bool, cmd byte, opt byte) {
+	if cmd != tnWILL && cmd != tnWONT {
+		return
+	}
+	switch {
+	case opt == OptionTN3270E && client:
+		s.tn3270e = cmd == tnWILL
+	case opt == OptionEOR && client:
+		s.clientEOR = cmd == tnWILL
+	case opt == OptionEOR:
+		s.hostEOR = cmd == tnWILL
+	}
+}
+
+// lineMode reports whether the session is still in NVT line mode, where
+// data is not sent as records
+func (s *ProxySession) lineMode() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return !s.tn3270e && !(s.clientEOR && s.hostEOR)
+}
+
+// forward copies what is read from src to dst until either side is closed.
+// The records and the telnet commands of src go through filter, the data
+// sent in NVT line mode is forwarded as soon as it is read.
+func (s *ProxySession) forward(dst, src net.Conn, filter func(unit []byte) []byte) {
+	defer dst.Close()
+	defer src.Close()
+	var pending []byte
+	buf := make([]byte, 2048)
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			pending = append(pending, buf[:n]...)
+			var out []byte
+			i := 0
+			for i < len(pending) {
+				if s.lineMode() {
+					if l := nvtLength(pending[i:]); l > 0 {
+						out = append(out, pending[i:i+l]...)
+						i += l
+						continue
+					}
+				}
+				l := unitLength(pending[i:])
+				if l == 0 {
+					break
+				}
+				out = append(out, filter(pending[i:i+l])...)
+				i += l
+			}
+			pending = append(pending[:0], pending[i:]...)
+			if len(out) > 0 {
+				if _, err := dst.Write(out); err != nil {
+					return
+				}
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// splitRecord returns the TN3270E header, nil in TN3270 mode, and the data
+// of a record. ok is false for the records that are not 3270 data.
+func (s *ProxySession) splitRecord(unit []byte) (header []byte, data []byte, ok bool) {
+	end := len(unit) - 2
+	if !s.tn3270e {
+		return nil, unescapeIAC(unit[:end]), true
+	}
+	if end < 5 || unit[0] != dataType3270 {
+		return nil, nil, false
+	}
+	return unit[:5], unescapeIAC(unit[5:end]), true
+}
+
+func joinRecord(header []byte, data []byte) []byte {
+	record := append([]byte(nil), header...)
+	record = append(record, escapeIAC(data)...)
+	return append(record, 0xff, 0xef)
+}
+
+// apply updates the screen of the session with a record sent by the host
+func (s *ProxySession) apply(screen *VirtualScreenTN3270Handler, data []byte) {
+	h := &proxyHandler{s}
+	NewParser(h, h, screen, h).Parse(joinRecord([]byte{dataType3270, 0, 0, 0, 0}, data))
+}
+
+// fromHost handles a unit sent by the host
+func (s *ProxySession) fromHost(unit []byte) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if unit[0] == 0xff && unit[1] == 0xfa {
+		data := unescapeIAC(unit[2 : len(unit)-2])
+		if len(data) > 0 && data[0] == 0x28 {
+			parseTN3270ESubneg(data[1:], &proxyHandler{s})
+		}
+		return unit
+	}
+	if unit[0] == 0xff && unit[1] != 0xff {
+		if len(unit) == 3 {
+			s.negotiated(false, unit[1], unit[2])
+		}
+		return unit
+	}
+	header, data, ok := s.splitRecord(unit)
+	if !ok {
+		return unit
+	}
+	before := s.screen.snapshot()
+	s.apply(s.screen, data)
+	if s.proxy.OnScreen == nil {
+		return unit
+	}
+	out := s.proxy.OnScreen(s, data)
+	switch {
+	case out == nil:
+		s.screen = before
+		return nil
+	case bytes.Equal(out, data):
+		return unit
+	}
+	// The client displays the rewritten record
+	s.screen = before
+	s.apply(s.screen, out)
+	return joinRecord(header, out)
+}
+
+// fromClient handles a unit sent by the client
+func (s *ProxySession) fromClient(unit []byte) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if unit[0] == 0xff && unit[1] != 0xff {
+		if len(unit) == 3 {
+			s.negotiated(true, unit[1], unit[2])
+		}
+		return unit
+	}
+	header, data, ok := s.splitRecord(unit)
+	if !ok || s.proxy.OnRequest == nil {
+		return unit
+	}
+	d := &requestDecoder{}
+	d.cp = codePageOrDefault(s.proxy.CodePage)
+	parseInbound(data, d)
+	r := d.request()
+	r.LUName = s.luname
+	r.Session = &s.Session
+	out := s.proxy.OnRequest(s, r, data)
+	switch {
+	case out == nil:
+		return nil
+	case bytes.Equal(out, data):
+		return unit
+	}
+	return joinRecord(header, out)
+}
+
+// close closes both sides of the session
+func (s *ProxySession) close() {
+	s.client.Close()
+	s.backend.Close()
+}
+
+// recoverHook ends the session when a hook panics
+func (s *ProxySession) recoverHook() {
+	if err := recover(); err != nil {
+		log.Printf("tn3270: panic proxying %s: %v\n%s", s.RemoteAddr, err, debug.Stack())
+		s.close()
+	}
+}
+
+func (s *ProxySession) serve() {
+	defer s.recoverHook()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer s.recoverHook()
+		s.forward(s.client, s.backend, s.fromHost)
+	}()
+	s.forward(s.backend, s.client, s.fromClient)
+	<-done
+}
+
+func (p *Proxy) dialBackend() (net.Conn, error) {
+	switch {
+	case p.Dial != nil:
+		return p.Dial()
+	case p.BackendTLSConfig != nil:
+		return tls.Dial("tcp", p.Backend, p.BackendTLSConfig)
+	}
+	return net.Dial("tcp", p.Backend)
+}
+
+func (p *Proxy) newSession(rwc net.Conn) (*ProxySession, error) {
+	backend, err := p.dialBackend()
+	if err != nil {
+		return nil, err
+	}
+	s := &ProxySession{
+		RemoteAddr: rwc.RemoteAddr().String(),
+		proxy:      p,
+		client:     rwc,
+		backend:    backend,
+		screen:     NewVirtualScreenTN3270Handler(24, 80),
+	}
+	s.screen.CodePage = p.CodePage
+	if p.Trace != nil {
+		s.client = newTraceConn(rwc, p.Trace, s.RemoteAddr+" ", p.CodePage, true)
+	}
+	return s, nil
+}
+
+// ListenAndServe listens on the TCP network address p.Addr and then calls
+// Serve to forward incoming connections. If p.Addr is blank, ":telnet" is
+// used.
+func (p *Proxy) ListenAndServe() error {
+	return p.track.listenAndServe(p.Addr, ":telnet", p.Serve)
+}
+
+// ListenAndServeTLS is like ListenAndServe but accepts TLS connections. If
+// p.Addr is blank, ":https" is used.
+func (p *Proxy) ListenAndServeTLS(certFile, keyFile string) error {
+	return p.track.listenAndServe(p.Addr, ":https", func(l net.Listener) error {
+		return p.ServeTLS(l, certFile, keyFile)
+	})
+}
+
+// ServeTLS accepts TLS connections on l, the certificate is loaded from the
+// files if p.TLSConfig holds none
+func (p *Proxy) ServeTLS(l net.Listener, certFile, keyFile string) error {
+	tl, err := tlsListener(l, p.TLSConfig, certFile, keyFile)
+	if err != nil {
+		return err
+	}
+	return p.Serve(tl)
+}
+
+// Serve accepts incoming connections on the Listener l and forwards each
+// of them to the backend in its own goroutine
+func (p *Proxy) Serve(l net.Listener) error {
+	return p.track.serve(l, p.ServeConn)
+}
+
+// ServeConn forwards a single connection and returns once it is closed
+func (p *Proxy) ServeConn(rwc net.Conn) {
+	s, err := p.newSession(rwc)
+	if err != nil {
+		log.Printf("tn3270: proxying %s: %v", rwc.RemoteAddr(), err)
+		rwc.Close()
+		return
+	}
+	if !p.track.trackConn(s, s.close) {
+		return
+	}
+	defer p.track.untrackConn(s)
+	s.serve()
+}
+
+// Close stops the listeners and closes all the forwarded connections, then
+// waits for the connections accepted by Serve to be done
+func (p *Proxy) Close() error {
+	return p.track.close()
+}
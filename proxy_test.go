@@ -0,0 +1,156 @@
+package tn3270_test
+
+import (
+	"bytes"
+	"net"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+	"github.com/wuzuf/go-tn3270/tn3270test"
+)
+
+var _ = Describe("Proxy", func() {
+	var host *tn3270test.Host
+	var proxy *tn3270.Proxy
+	var client *tn3270.Client
+
+	BeforeEach(func() {
+		host = tn3270test.NewHost(
+			tn3270test.Step{Screen: "WELCOME TO THE HOST"},
+			tn3270test.Step{
+				Expect: &tn3270test.Input{AID: tn3270.AIDEnter, Text: "LOGON"},
+				Screen: "READY",
+			},
+		)
+		proxy = &tn3270.Proxy{Dial: func() (net.Conn, error) {
+			return host.Pipe(), nil
+		}}
+		client = tn3270.NewClient("LU000001")
+	})
+
+	connect := func() chan string {
+		proxySide, clientSide := net.Pipe()
+		go proxy.ServeConn(proxySide)
+		recv, err := client.ConnectConn(clientSide)
+		Expect(err).To(Succeed())
+		return recv
+	}
+
+	AfterEach(func() {
+		client.Close()
+		proxy.Close()
+		host.Close()
+	})
+
+	It("Should forward sessions untouched without hooks", func() {
+		recv := connect()
+		Expect(<-recv).To(Equal("WELCOME TO THE HOST"))
+		Expect(client.SendRecv("LOGON")).To(Equal("READY"))
+		Expect(host.Err()).To(Succeed())
+	})
+
+	It("Should expose the screens and requests to the hooks", func() {
+		var mu sync.Mutex
+		var screens []string
+		var requests []*tn3270.Request
+		var lu string
+		proxy.OnScreen = func(s *tn3270.ProxySession, data []byte) []byte {
+			mu.Lock()
+			defer mu.Unlock()
+			screens = append(screens, s.Screen().String())
+			lu = s.LUName()
+			return data
+		}
+		proxy.OnRequest = func(s *tn3270.ProxySession, r *tn3270.Request, data []byte) []byte {
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, r)
+			return data
+		}
+		recv := connect()
+		<-recv
+		Expect(client.SendRecv("LOGON")).To(Equal("READY"))
+
+		mu.Lock()
+		defer mu.Unlock()
+		Expect(screens).To(Equal([]string{"WELCOME TO THE HOST", "READY"}))
+		Expect(lu).To(Equal("LU000001"))
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].AID).To(Equal(tn3270.AIDEnter))
+		Expect(requests[0].Text).To(Equal("LOGON"))
+		Expect(requests[0].LUName).To(Equal("LU000001"))
+	})
+
+	It("Should rewrite screens and requests", func() {
+		cp := tn3270.LookupCodePage("cp037")
+		proxy.OnScreen = func(s *tn3270.ProxySession, data []byte) []byte {
+			return bytes.Replace(data, cp.Encode("HOST"), cp.Encode("PROXY"), -1)
+		}
+		proxy.OnRequest = func(s *tn3270.ProxySession, r *tn3270.Request, data []byte) []byte {
+			return bytes.Replace(data, cp.Encode("logon"), cp.Encode("LOGON"), -1)
+		}
+		recv := connect()
+		Expect(<-recv).To(Equal("WELCOME TO THE PROXY"))
+		Expect(client.SendRecv("logon")).To(Equal("READY"))
+		Expect(host.Err()).To(Succeed())
+	})
+
+	It("Should drop the records the hooks return nil for", func() {
+		proxy.OnRequest = func(s *tn3270.ProxySession, r *tn3270.Request, data []byte) []byte {
+			if r.Text == "SECRET" {
+				return nil
+			}
+			return data
+		}
+		recv := connect()
+		<-recv
+		client.Send("SECRET")
+		Expect(client.SendRecv("LOGON")).To(Equal("READY"))
+		Expect(host.Err()).To(Succeed())
+	})
+	It("Should keep accepting connections once the previous ones are done", func() {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(Succeed())
+		go proxy.Serve(l)
+		for i := 0; i < 2; i++ {
+			c := tn3270.NewClient("")
+			recv, err := c.Connect(l.Addr().String())
+			Expect(err).To(Succeed())
+			Expect(<-recv).To(Equal("WELCOME TO THE HOST"))
+			c.Close()
+			Eventually(c.Done()).Should(BeClosed())
+		}
+	})
+	It("Should forward NVT data before 3270 mode right away", func() {
+		backend, hostSide := net.Pipe()
+		defer hostSide.Close()
+		proxy.Dial = func() (net.Conn, error) { return backend, nil }
+		proxySide, clientSide := net.Pipe()
+		defer clientSide.Close()
+		go proxy.ServeConn(proxySide)
+
+		buf := make([]byte, 16)
+		go hostSide.Write([]byte("LOGIN: "))
+		clientSide.SetReadDeadline(time.Now().Add(time.Second))
+		n, err := clientSide.Read(buf)
+		Expect(err).To(Succeed())
+		Expect(string(buf[:n])).To(Equal("LOGIN: "))
+
+		go clientSide.Write([]byte("JDOE\r\n"))
+		hostSide.SetReadDeadline(time.Now().Add(time.Second))
+		n, err = hostSide.Read(buf)
+		Expect(err).To(Succeed())
+		Expect(string(buf[:n])).To(Equal("JDOE\r\n"))
+	})
+	It("Should end the sessions whose hooks panic", func() {
+		proxy.OnScreen = func(*tn3270.ProxySession, []byte) []byte {
+			panic("broken hook")
+		}
+		connect()
+		Eventually(client.Done()).Should(BeClosed())
+	})
+})
@@ -0,0 +1,201 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Recordings are text files with one event per line:
+//
+//   <seconds since start> <direction> <hex data>
+//
+// where direction is '<' for data received and '>' for data sent. Lines
+// starting with '#' are comments.
+const recordingHeader = "# go-tn3270 recording\n"
+
+// Direction tells whether recorded data was received or sent
+type Direction byte
+
+const (
+	Received Direction = '<'
+	Sent     Direction = '>'
+)
+
+// RecordEvent is a chunk of data captured by a Recorder
+type RecordEvent struct {
+	Time time.Duration // time since the start of the recording
+	Dir  Direction
+	Data []byte
+}
+
+// Recorder captures both directions of a session with timestamps
+type Recorder struct {
+	mu     sync.Mutex
+	w      io.Writer
+	start  time.Time
+	header bool
+}
+
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w, start: time.Now()}
+}
+
+// Record writes an event to the recording
+func (r *Recorder) Record(dir Direction, data []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.header {
+		r.header = true
+		if _, err := io.WriteString(r.w, recordingHeader); err != nil {
+			return err
+		}
+	}
+	elapsed := time.Since(r.start).Seconds()
+	_, err := fmt.Fprintf(r.w, "%.6f %c %x\n", elapsed, dir, data)
+	return err
+}
+
+// Conn wraps a connection so that everything read from and written to it is
+// recorded
+func (r *Recorder) Conn(c net.Conn) net.Conn {
+	return &recordingConn{Conn: c, r: r}
+}
+
+type recordingConn struct {
+	net.Conn
+	r *Recorder
+}
+
+func (c *recordingConn) Read(p []byte) (n int, err error) {
+	n, err = c.Conn.Read(p)
+	if n > 0 {
+		c.r.Record(Received, p[:n])
+	}
+	return
+}
+
+func (c *recordingConn) Write(p []byte) (n int, err error) {
+	n, err = c.Conn.Write(p)
+	if n > 0 {
+		c.r.Record(Sent, p[:n])
+	}
+	return
+}
+
+// ReadRecording parses a recording
+func ReadRecording(r io.Reader) ([]RecordEvent, error) {
+	var events []RecordEvent
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 3 || len(fields[1]) != 1 {
+			return nil, fmt.Errorf("recording line %d: invalid event", line)
+		}
+		seconds, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("recording line %d: %s", line, err)
+		}
+		dir := Direction(fields[1][0])
+		if dir != Received && dir != Sent {
+			return nil, fmt.Errorf("recording line %d: invalid direction %q", line, fields[1])
+		}
+		data, err := hex.DecodeString(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("recording line %d: %s", line, err)
+		}
+		events = append(events, RecordEvent{
+			Time: time.Duration(seconds * float64(time.Second)),
+			Dir:  dir,
+			Data: data,
+		})
+	}
+	return events, scanner.Err()
+}
+
+// Replay feeds the data received during a recorded session to a parser, so
+// that handlers such as VirtualScreenTN3270Handler go through the same
+// states as in the original session.
+func Replay(events []RecordEvent, p Parser) error {
+	for _, e := range events {
+		if e.Dir != Received {
+			continue
+		}
+		if err := p.Parse(e.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplayMismatchError is returned by ReplayHost when the peer does not send
+// what was recorded
+type ReplayMismatchError struct {
+	Event    int // index of the event in the recording
+	Expected []byte
+	Actual   []byte
+}
+
+func (e *ReplayMismatchError) Error() string {
+	return fmt.Sprintf("replay event %d: expected %x, got %x", e.Event, e.Expected, e.Actual)
+}
+
+// ReplayHost plays the host side of a session recorded by a client on conn:
+// data received by the client is written to conn and data sent by the client
+// is read from conn and compared to the recording. The original timing is
+// kept if realTime is set, otherwise data is written as soon as possible.
+func ReplayHost(conn io.ReadWriter, events []RecordEvent, realTime bool) error {
+	start := time.Now()
+	for i, e := range events {
+		switch e.Dir {
+		case Received:
+			if realTime {
+				time.Sleep(e.Time - time.Since(start))
+			}
+			if _, err := conn.Write(e.Data); err != nil {
+				return err
+			}
+		case Sent:
+			// Compare as data comes so that shorter messages are caught
+			data := make([]byte, 0, len(e.Data))
+			buf := make([]byte, len(e.Data))
+			for len(data) < len(e.Data) {
+				n, err := conn.Read(buf[:len(e.Data)-len(data)])
+				data = append(data, buf[:n]...)
+				if !bytes.HasPrefix(e.Data, data) {
+					return &ReplayMismatchError{Event: i, Expected: e.Data, Actual: data}
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package tn3270_test
+
+import (
+	"bytes"
+	"net"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+var _ = Describe("Recording", func() {
+	var events []tn3270.RecordEvent
+
+	BeforeEach(func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(Succeed())
+		server := &tn3270.Server{Handler: &MyHandler{}}
+		go server.Serve(listener)
+		defer server.Close()
+
+		var buf bytes.Buffer
+		client := tn3270.NewClient("09123456")
+		client.Recorder = tn3270.NewRecorder(&buf)
+		recv, err := client.Connect(listener.Addr().String())
+		Expect(err).To(Succeed())
+		<-recv
+		Expect(client.SendRecv("Hello")).To(Equal("ECHO: Hello"))
+
+		Expect(buf.String()).To(HavePrefix("# go-tn3270 recording\n"))
+		events, err = tn3270.ReadRecording(strings.NewReader(buf.String()))
+		Expect(err).To(Succeed())
+	})
+
+	It("Should capture both directions", func() {
+		Expect(events[0].Dir).To(Equal(tn3270.Received))
+		Expect(events[0].Data).To(HavePrefix("\xff\xfd\x18"))
+		Expect(events[1].Dir).To(Equal(tn3270.Sent))
+		Expect(events[1].Data).To(Equal([]byte{0xff, 0xfb, 0x18}))
+	})
+
+	It("Should replay to a virtual screen", func() {
+		screen := tn3270.NewVirtualScreenTN3270Handler(24, 80)
+		p := tn3270.NewParser(nopHandler{}, nopHandler{}, screen, &tn3270.VerboseErrorHandler{})
+		Expect(tn3270.Replay(events, p)).To(Succeed())
+		Expect(screen.String()).To(Equal("ECHO: Hello"))
+	})
+
+	It("Should replay the host side", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(Succeed())
+		defer listener.Close()
+		done := make(chan error, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				done <- err
+				return
+			}
+			defer conn.Close()
+			done <- tn3270.ReplayHost(conn, events, false)
+		}()
+
+		client := tn3270.NewClient("09123456")
+		recv, err := client.Connect(listener.Addr().String())
+		Expect(err).To(Succeed())
+		Expect(<-recv).To(Equal("WELCOME TO MY TN3270 SERVER"))
+		Expect(client.SendRecv("Hello")).To(Equal("ECHO: Hello"))
+		Expect(<-done).To(Succeed())
+	})
+
+	It("Should detect diverging clients", func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(Succeed())
+		defer listener.Close()
+		done := make(chan error, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				done <- err
+				return
+			}
+			defer conn.Close()
+			done <- tn3270.ReplayHost(conn, events, false)
+		}()
+
+		client := tn3270.NewClient("09123456")
+		recv, err := client.Connect(listener.Addr().String())
+		Expect(err).To(Succeed())
+		<-recv
+		client.Send("Bye")
+		Expect(<-done).To(BeAssignableToTypeOf(&tn3270.ReplayMismatchError{}))
+	})
+})
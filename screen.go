@@ -0,0 +1,503 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"errors"
+	"strings"
+)
+
+// charsetDBCS is the character set attribute value of double byte fields
+// and characters
+const charsetDBCS = 0xf8
+
+// Field attribute bits
+const (
+	FieldProtected   = 0x20
+	FieldNumeric     = 0x10
+	FieldIntensified = 0x08
+	FieldHidden      = 0x0c
+	FieldModified    = 0x01
+)
+
+var (
+	ErrKeyboardLocked = errors.New("tn3270: keyboard locked")
+	ErrProtected      = errors.New("tn3270: protected field")
+)
+
+// Cell is a position of the screen
+type Cell struct {
+	Char       rune // 0 on field attributes and second halves of DBCS characters
+	FieldStart bool // the cell holds a field attribute
+	Attr       byte // field attribute, if FieldStart
+}
+
+// VirtualScreenTN3270Handler is a TN3270 handler that simulates a terminal
+// and keeps track of the terminal display as if it was a GUI
+type VirtualScreenTN3270Handler struct {
+	screen           Screen
+	charsets         []byte // character set of fields (on attribute cells) and characters
+	attrs            []byte // field attributes (on attribute cells)
+	rows, cols       int
+	position, cursor int
+	charset          byte // character set selected by SA orders
+	locked           bool // keyboard locked until a WCC restores it
+
+	// sizes selected by Erase/Write and Erase/Write Alternate
+	defaultRows, defaultCols int
+	altRows, altCols         int
+
+	CodePage      *CodePage // CP037 if nil
+	HandleMessage func(string)
+}
+
+// NewVirtualScreenTN3270Handler returns a blank screen of the given size
+func NewVirtualScreenTN3270Handler(rows, cols int) *VirtualScreenTN3270Handler {
+	h := &VirtualScreenTN3270Handler{}
+	h.setSizes(rows, cols, rows, cols)
+	return h
+}
+
+// setSizes sets the default and alternate sizes of the screen, and clears
+// it with the default size
+func (h *VirtualScreenTN3270Handler) setSizes(rows, cols, altRows, altCols int) {
+	h.defaultRows, h.defaultCols = rows, cols
+	h.altRows, h.altCols = altRows, altCols
+	h.rows, h.cols = rows, cols
+	h.clear()
+	h.charset = 0
+	h.locked = false
+}
+
+func (h *VirtualScreenTN3270Handler) clear() {
+	h.screen = make([]byte, h.rows*h.cols)
+	h.charsets = make([]byte, h.rows*h.cols)
+	h.attrs = make([]byte, h.rows*h.cols)
+	h.position = 0
+	h.cursor = 0
+}
+
+// snapshot returns a copy of the screen that is not updated anymore
+func (h *VirtualScreenTN3270Handler) snapshot() *VirtualScreenTN3270Handler {
+	c := *h
+	c.screen = append(Screen(nil), h.screen...)
+	c.charsets = append([]byte(nil), h.charsets...)
+	c.attrs = append([]byte(nil), h.attrs...)
+	c.HandleMessage = nil
+	return &c
+}
+
+func (h *VirtualScreenTN3270Handler) Rows() int {
+	return h.rows
+}
+
+func (h *VirtualScreenTN3270Handler) Cols() int {
+	return h.cols
+}
+
+// Cursor returns the buffer address of the cursor
+func (h *VirtualScreenTN3270Handler) Cursor() int {
+	return h.cursor
+}
+
+// MoveCursor moves the cursor to a buffer address
+func (h *VirtualScreenTN3270Handler) MoveCursor(addr int) {
+	h.cursor = (addr%h.size() + h.size()) % h.size()
+}
+
+// KeyboardLocked tells whether the keyboard is locked, it is locked once an
+// AID is sent and until the host restores it
+func (h *VirtualScreenTN3270Handler) KeyboardLocked() bool {
+	return h.locked
+}
+
+// LockKeyboard locks the keyboard until the host restores it
+func (h *VirtualScreenTN3270Handler) LockKeyboard() {
+	h.locked = true
+}
+
+// Formatted tells whether the screen has fields
+func (h *VirtualScreenTN3270Handler) Formatted() bool {
+	for _, b := range h.screen {
+		if b == 0x1d {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldStart returns the address of the attribute of the field holding
+// addr, -1 if the screen is not formatted
+func (h *VirtualScreenTN3270Handler) fieldStart(addr int) int {
+	n := h.size()
+	for i := 0; i < n; i++ {
+		idx := (addr - i + n) % n
+		if h.screen[idx] == 0x1d {
+			return idx
+		}
+	}
+	return -1
+}
+
+// Protected tells whether the character at addr cannot be typed over
+func (h *VirtualScreenTN3270Handler) Protected(addr int) bool {
+	start := h.fieldStart(addr)
+	if start == -1 {
+		return false
+	}
+	return start == addr || h.attrs[start]&FieldProtected != 0
+}
+
+// Fields returns the fields of the screen, in buffer order
+func (h *VirtualScreenTN3270Handler) Fields() []Field {
+	var fields []Field
+	cells := h.cells()
+	n := h.size()
+	for start := 0; start < n; start++ {
+		if h.screen[start] != 0x1d {
+			continue
+		}
+		var sb strings.Builder
+		addr := (start + 1) % n
+		for i := addr; h.screen[i] != 0x1d; i = (i + 1) % n {
+			if cells[i] != 0 {
+				sb.WriteRune(cells[i])
+			}
+		}
+		fields = append(fields, Field{Addr: addr, Attr: h.attrs[start], Value: sb.String()})
+	}
+	return fields
+}
+
+// Cells returns the content of each position of the screen
+func (h *VirtualScreenTN3270Handler) Cells() []Cell {
+	runes := h.cells()
+	cells := make([]Cell, len(runes))
+	for i, r := range runes {
+		if h.screen[i] == 0x1d {
+			cells[i] = Cell{FieldStart: true, Attr: h.attrs[i]}
+		} else {
+			cells[i] = Cell{Char: r}
+		}
+	}
+	return cells
+}
+
+// Type types text at the cursor as an operator would, setting the modified
+// flag of the fields and skipping to the next unprotected field at the end
+// of a field
+func (h *VirtualScreenTN3270Handler) Type(s string) error {
+	if h.locked {
+		return ErrKeyboardLocked
+	}
+	cp := codePageOrDefault(h.CodePage)
+	for _, r := range s {
+		if h.Protected(h.cursor) {
+			return ErrProtected
+		}
+		b, ok := cp.EncodeRune(r)
+		if !ok {
+			b, _ = cp.EncodeRune('?')
+		}
+		h.screen[h.cursor] = b
+		h.charsets[h.cursor] = 0
+		if start := h.fieldStart(h.cursor); start != -1 {
+			h.attrs[start] |= FieldModified
+		}
+		h.cursor = (h.cursor + 1) % h.size()
+		if h.screen[h.cursor] == 0x1d {
+			h.Tab()
+		}
+	}
+	return nil
+}
+
+// EraseEOF erases the field holding the cursor from the cursor to its end
+func (h *VirtualScreenTN3270Handler) EraseEOF() error {
+	if h.locked {
+		return ErrKeyboardLocked
+	}
+	if h.Protected(h.cursor) {
+		return ErrProtected
+	}
+	start := h.fieldStart(h.cursor)
+	if start == -1 {
+		// Unformatted screens are erased up to their end
+		for i := h.cursor; i < h.size(); i++ {
+			h.screen[i] = 0x00
+		}
+		return nil
+	}
+	h.attrs[start] |= FieldModified
+	for i := h.cursor; h.screen[i] != 0x1d; i = (i + 1) % h.size() {
+		h.screen[i] = 0x00
+		h.charsets[i] = 0
+	}
+	return nil
+}
+
+// Tab moves the cursor to the first position of the next unprotected field
+func (h *VirtualScreenTN3270Handler) Tab() {
+	n := h.size()
+	for i := 1; i <= n; i++ {
+		idx := (h.cursor + i) % n
+		if h.screen[idx] == 0x1d && h.attrs[idx]&FieldProtected == 0 && h.screen[(idx+1)%n] != 0x1d {
+			h.cursor = (idx + 1) % n
+			return
+		}
+	}
+}
+
+// ReadModified returns the inbound data stream sent with an AID: the AID,
+// the cursor address and the modified fields, or only the AID for short
+// reads. Unformatted screens send the whole buffer.
+func (h *VirtualScreenTN3270Handler) ReadModified(aid AID) []byte {
+	data := []byte{byte(aid)}
+	if aid.ShortRead() {
+		return data
+	}
+	data = append(data, encodeAddr(h.cursor)...)
+	n := h.size()
+	if !h.Formatted() {
+		for _, b := range h.screen {
+			if b != 0x00 {
+				data = append(data, b)
+			}
+		}
+		return data
+	}
+	for start := 0; start < n; start++ {
+		if h.screen[start] != 0x1d || h.attrs[start]&FieldModified == 0 {
+			continue
+		}
+		addr := (start + 1) % n
+		data = append(data, 0x11)
+		data = append(data, encodeAddr(addr)...)
+		for i := addr; h.screen[i] != 0x1d; i = (i + 1) % n {
+			// Nulls are not sent
+			if h.screen[i] != 0x00 {
+				data = append(data, h.screen[i])
+			}
+		}
+	}
+	return data
+}
+
+func (h *VirtualScreenTN3270Handler) size() int {
+	return h.rows * h.cols
+}
+
+// cells decodes the screen buffer, one character per cell. The second cell
+// of double byte characters is 0.
+func (h *VirtualScreenTN3270Handler) cells() []rune {
+	cp := codePageOrDefault(h.CodePage)
+	n := h.size()
+	cells := make([]rune, n)
+	// The field of the first cell starts at the last attribute of the buffer
+	var field byte
+	for i := n - 1; i >= 0; i-- {
+		if h.screen[i] == 0x1d {
+			field = h.charsets[i]
+			break
+		}
+	}
+	shifted := false
+	for i := 0; i < n; i++ {
+		b := h.screen[i]
+		dbcs := shifted || h.charsets[i] == charsetDBCS || (h.charsets[i] == 0 && field == charsetDBCS)
+		switch {
+		case b == 0x1d:
+			field = h.charsets[i]
+			shifted = false
+			cells[i] = ' '
+		case b == 0x00:
+			// Nulls are displayed as blanks
+			cells[i] = ' '
+		case cp.IsDBCS() && b == SO:
+			shifted = true
+			cells[i] = ' '
+		case cp.IsDBCS() && b == SI:
+			shifted = false
+			cells[i] = ' '
+		case dbcs && cp.IsDBCS() && i+1 < n:
+			cells[i] = cp.DecodeDBCS(b, h.screen[i+1])
+			i++
+		default:
+			cells[i] = cp.DecodeByte(b)
+		}
+	}
+	return cells
+}
+
+func (h *VirtualScreenTN3270Handler) String() string {
+	cells := h.cells()
+	rows := make([]string, h.rows)
+	for i := 0; i < h.rows; i++ {
+		var sb strings.Builder
+		for _, r := range cells[i*h.cols : (i+1)*h.cols] {
+			if r != 0 {
+				sb.WriteRune(r)
+			}
+		}
+		rows[i] = strings.TrimRight(sb.String(), " \u3000")
+	}
+	// Trim empty lines
+	var beg, end int
+	var started bool = false
+	for i := 0; i < h.rows; i++ {
+		if len(rows[i]) != 0 {
+			if !started {
+				beg = i
+			}
+			started = true
+			end = i
+		}
+	}
+	// Generate screen string
+	return strings.Join(rows[beg:end+1], "\n")
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270Command(b byte) {
+	switch b {
+	case 0x05, 0xf5:
+		// Erase/Write clears the screen with the default size
+		h.rows, h.cols = h.defaultRows, h.defaultCols
+		h.clear()
+	case 0x0d, 0x7e:
+		// Erase/Write Alternate clears it with the alternate size
+		h.rows, h.cols = h.altRows, h.altCols
+		h.clear()
+	case 0x0f, 0x6f:
+		h.eraseAllUnprotected()
+	}
+	h.charset = 0
+}
+
+func (h *VirtualScreenTN3270Handler) eraseAllUnprotected() {
+	n := h.size()
+	for i := 0; i < n; i++ {
+		if h.screen[i] == 0x1d {
+			h.attrs[i] &^= FieldModified
+		} else if !h.Protected(i) {
+			h.screen[i] = 0x00
+		}
+	}
+	h.locked = false
+	h.cursor = n - 1
+	h.Tab()
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270WCC(b byte) {
+	if b&0x01 != 0 {
+		// Reset MDT
+		for i := range h.attrs {
+			h.attrs[i] &^= FieldModified
+		}
+	}
+	if b&0x02 != 0 {
+		// Keyboard restore
+		h.locked = false
+	}
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270AID(b byte) {
+	// Nothing to be done on AID
+}
+
+func (h *VirtualScreenTN3270Handler) startField(attr byte) {
+	h.screen[h.position] = 0x1d
+	h.charsets[h.position] = 0
+	h.attrs[h.position] = attr
+	h.position = (h.position + 1) % h.size()
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270SF(b byte) {
+	h.startField(b)
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270SFE(b byte) {
+	h.startField(0)
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270FieldAttribute(t byte, v byte) {
+	last := (h.position + h.size() - 1) % h.size()
+	switch t {
+	case 0xc0:
+		// Field attribute of the field started by the SFE
+		h.attrs[last] = v
+	case 0x43:
+		// Character set of the field started by the SFE
+		h.charsets[last] = v
+	}
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270SA(t byte, v byte) {
+	switch t {
+	case 0x00:
+		// Reset all character attributes
+		h.charset = 0
+	case 0x43:
+		h.charset = v
+	}
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270PT() {
+	// Move to next start field
+	var idx int
+	for i := 0; i < h.size(); i++ {
+		idx = (h.position + i) % h.size()
+		if h.screen[idx] == 0x1d {
+			break
+		}
+		h.screen[idx] = 0x00
+	}
+	h.position = (idx + 1) % h.size()
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270IC() {
+	h.cursor = h.position
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270Text(b []byte) {
+	for _, c := range b {
+		h.screen[h.position] = c
+		h.charsets[h.position] = h.charset
+		h.position = (h.position + 1) % h.size()
+	}
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270RA(addr int, b byte) {
+	for ; h.position != addr; h.position = (h.position + 1) % h.size() {
+		h.screen[h.position] = b
+		h.charsets[h.position] = h.charset
+	}
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270SBA(addr int) {
+	h.position = addr % h.size()
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270EUA(addr int) {
+	for ; h.position != addr; h.position = (h.position + 1) % h.size() {
+		h.screen[h.position] = 0x00
+		h.charsets[h.position] = 0
+	}
+}
+
+func (h *VirtualScreenTN3270Handler) OnTN3270Message() {
+	if h.HandleMessage != nil {
+		h.HandleMessage(h.String())
+	}
+}
@@ -0,0 +1,327 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"strings"
+)
+
+// SCS control codes
+const (
+	scsHT  = 0x05 // horizontal tab
+	scsVT  = 0x0b // vertical tab
+	scsFF  = 0x0c // form feed
+	scsCR  = 0x0d // carriage return
+	scsNL  = 0x15 // new line
+	scsBS  = 0x16 // backspace
+	scsEM  = 0x19 // end of message, ends LU3 print buffers
+	scsIRS = 0x1e // interchange record separator
+	scsLF  = 0x25 // line feed
+	scsCSP = 0x2b // control sequence prefix, SHF, SVF, ...
+	scsTRN = 0x35 // transparent data
+	scsSHF = 0xc1 // set horizontal format, after CSP
+	scsSVF = 0xc2 // set vertical format, after CSP
+)
+
+// defaultLineLength is the maximum print position of 3287 printers
+const defaultLineLength = 132
+
+// pageLayout lays out the pages of a print job as a 3287 printer does.
+// Rows and columns start at 0, SCS parameters start at 1.
+type pageLayout struct {
+	cp       *CodePage
+	pages    []string
+	lines    [][]rune // lines of the current page
+	row, col int
+
+	mpp, lm, rm  int // maximum print position, left and right margins
+	mpl, tm, bm  int // maximum page length, top and bottom margins
+	htabs, vtabs []int
+}
+
+func newPageLayout(cp *CodePage) *pageLayout {
+	return &pageLayout{cp: cp, mpp: defaultLineLength}
+}
+
+// lineLength returns the number of columns that can be printed on a line
+func (l *pageLayout) lineLength() int {
+	if l.rm > 0 && l.rm < l.mpp {
+		return l.rm
+	}
+	return l.mpp
+}
+
+// put prints characters at the current position, going to the next line
+// at the right margin
+func (l *pageLayout) put(s string) {
+	for _, r := range s {
+		if l.col >= l.lineLength() {
+			l.newLine()
+		}
+		for len(l.lines) <= l.row {
+			l.lines = append(l.lines, nil)
+		}
+		line := l.lines[l.row]
+		for len(line) <= l.col {
+			line = append(line, ' ')
+		}
+		line[l.col] = r
+		l.lines[l.row] = line
+		l.col++
+	}
+}
+
+func (l *pageLayout) carriageReturn() {
+	l.col = l.lm
+}
+
+func (l *pageLayout) lineFeed() {
+	l.row++
+	if l.mpl > 0 && l.row >= l.mpl || l.bm > 0 && l.row >= l.bm {
+		col := l.col
+		l.formFeed()
+		l.col = col
+	}
+}
+
+func (l *pageLayout) newLine() {
+	l.carriageReturn()
+	l.lineFeed()
+}
+
+func (l *pageLayout) formFeed() {
+	l.pages = append(l.pages, l.page())
+	l.lines = nil
+	l.row = l.tm
+	l.col = l.lm
+}
+
+func (l *pageLayout) horizontalTab() {
+	for _, t := range l.htabs {
+		if t > l.col {
+			l.put(strings.Repeat(" ", t-l.col))
+			return
+		}
+	}
+	if len(l.htabs) == 0 {
+		l.put(strings.Repeat(" ", 8-l.col%8))
+		return
+	}
+	l.put(" ")
+}
+
+func (l *pageLayout) verticalTab() {
+	for _, t := range l.vtabs {
+		if t > l.row {
+			// a stop below the bottom of the page ends on the next page
+			for pages := len(l.pages); l.row < t && len(l.pages) == pages; {
+				l.lineFeed()
+			}
+			return
+		}
+	}
+	l.lineFeed()
+}
+
+// page returns the text of the current page
+func (l *pageLayout) page() string {
+	lines := make([]string, len(l.lines))
+	for i, line := range l.lines {
+		lines[i] = strings.TrimRight(string(line), " ")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// empty reports whether nothing was printed since the last job
+func (l *pageLayout) empty() bool {
+	return len(l.pages) == 0 && len(l.lines) == 0
+}
+
+// job returns the pages printed so far and starts a new job
+func (l *pageLayout) job() []string {
+	if len(l.lines) > 0 {
+		l.formFeed()
+	}
+	pages := l.pages
+	l.pages = nil
+	l.row, l.col = l.tm, l.lm
+	return pages
+}
+
+// setHorizontalFormat handles the parameters of a SHF control
+func (l *pageLayout) setHorizontalFormat(params []byte) {
+	l.mpp, l.lm, l.rm, l.htabs = defaultLineLength, 0, 0, nil
+	if len(params) > 0 && params[0] > 0 {
+		l.mpp = int(params[0])
+	}
+	if len(params) > 1 && params[1] > 0 {
+		l.lm = int(params[1]) - 1
+	}
+	if len(params) > 2 {
+		l.rm = int(params[2])
+	}
+	for _, t := range params[min(len(params), 3):] {
+		if t > 0 {
+			l.htabs = append(l.htabs, int(t)-1)
+		}
+	}
+	if l.col < l.lm {
+		l.col = l.lm
+	}
+}
+
+// setVerticalFormat handles the parameters of a SVF control
+func (l *pageLayout) setVerticalFormat(params []byte) {
+	l.mpl, l.tm, l.bm, l.vtabs = 0, 0, 0, nil
+	if len(params) > 0 {
+		l.mpl = int(params[0])
+	}
+	if len(params) > 1 && params[1] > 0 {
+		l.tm = int(params[1]) - 1
+	}
+	if len(params) > 2 {
+		l.bm = int(params[2])
+	}
+	for _, t := range params[min(len(params), 3):] {
+		if t > 0 {
+			l.vtabs = append(l.vtabs, int(t)-1)
+		}
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// printSCS prints SNA character string data
+func (l *pageLayout) printSCS(data []byte) {
+	i := 0
+	for i < len(data) {
+		b := data[i]
+		if b >= 0x40 {
+			// Printable characters up to the next control
+			end := i + 1
+			for end < len(data) && data[end] >= 0x40 {
+				end++
+			}
+			l.put(l.cp.Decode(data[i:end]))
+			i = end
+			continue
+		}
+		i++
+		switch b {
+		case scsNL, scsIRS:
+			l.newLine()
+		case scsCR:
+			l.carriageReturn()
+		case scsLF:
+			l.lineFeed()
+		case scsFF:
+			l.formFeed()
+		case scsHT:
+			l.horizontalTab()
+		case scsVT:
+			l.verticalTab()
+		case scsBS:
+			if l.col > l.lm {
+				l.col--
+			}
+		case scsTRN:
+			if i < len(data) {
+				n := int(data[i])
+				end := min(len(data), i+1+n)
+				l.put(l.cp.Decode(data[i+1 : end]))
+				i = end
+			}
+		case scsCSP:
+			// CSP class count parameters, the count includes itself
+			if i+1 >= len(data) {
+				i = len(data)
+				break
+			}
+			class, n := data[i], int(data[i+1])
+			end := min(len(data), i+1+max(n, 1))
+			params := data[min(i+2, end):end]
+			switch class {
+			case scsSHF:
+				l.setHorizontalFormat(params)
+			case scsSVF:
+				l.setVerticalFormat(params)
+			}
+			i = end
+		}
+	}
+}
+
+// printBuffer prints the buffer of a LU3 printer as requested by the WCC of
+// the write that started the print. lineLength is 0 for unformatted prints,
+// where the NL, CR, FF and EM orders of the buffer are honored.
+func (l *pageLayout) printBuffer(buffer []byte, lineLength int) {
+	if lineLength > 0 {
+		for start := 0; start < len(buffer); start += lineLength {
+			end := min(len(buffer), start+lineLength)
+			var line []byte
+			for _, b := range buffer[start:end] {
+				if b < 0x40 {
+					b = 0x40
+				}
+				line = append(line, b)
+			}
+			l.put(l.cp.Decode(line))
+			l.newLine()
+		}
+		return
+	}
+	i := 0
+	for i < len(buffer) {
+		b := buffer[i]
+		if b >= 0x40 {
+			end := i + 1
+			for end < len(buffer) && buffer[end] >= 0x40 {
+				end++
+			}
+			l.put(l.cp.Decode(buffer[i:end]))
+			i = end
+			continue
+		}
+		i++
+		switch b {
+		case scsNL:
+			l.newLine()
+		case scsCR:
+			l.carriageReturn()
+		case scsFF:
+			l.formFeed()
+		case scsEM:
+			return
+		case 0x1d:
+			// Field attributes print as blanks
+			l.put(" ")
+		}
+	}
+	if l.col > l.lm {
+		l.newLine()
+	}
+}
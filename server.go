@@ -21,50 +21,168 @@ import (
 	"io"
 	"log"
 	"net"
+	"runtime/debug"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/juju/errors"
-	"gopkg.in/tomb.v2"
 )
 
-// debugServerConnections controls whether all server connections are wrapped
-// with a verbose logging wrapper.
-const debugServerConnections = false
-
 // noLimit is an effective infinite upper bound for io.LimitedReader
 const noLimit int64 = (1 << 63) - 1
 
+// Field is a field of a screen or a modified field of a Request
+type Field struct {
+	Addr  int  // buffer address of the first character of the field
+	Attr  byte // field attribute, 0 in requests
+	Value string
+}
+
+func (f Field) Protected() bool {
+	return f.Attr&FieldProtected != 0
+}
+
+func (f Field) Numeric() bool {
+	return f.Attr&FieldNumeric != 0
+}
+
+func (f Field) Hidden() bool {
+	return f.Attr&FieldHidden == FieldHidden
+}
+
+func (f Field) Modified() bool {
+	return f.Attr&FieldModified != 0
+}
+
 type Request struct {
-	Text string
+	Text    string
+	Fields  []Field  // modified fields, in the order they were sent
+	AID     AID      // key that sent the request
+	LUName  string   // LU assigned to the session
+	Session *Session // state kept across the requests of the connection
+
+	// Functions are the TN3270E functions negotiated with the terminal
+	Functions Functions
+
+	server *Server
+}
+
+// Printer returns the printer session associated with the terminal that
+// sent the request, see Server.Printer
+func (r *Request) Printer() (*PrinterSession, error) {
+	if r.server == nil {
+		return nil, ErrNoPrinter
+	}
+	return r.server.Printer(r.LUName)
 }
 
 type ResponseWriter interface {
 	io.Writer
 }
 
+// ResponseController is implemented by the ResponseWriter of the Server and
+// changes how the response is sent. Its methods must be called before the
+// first Write.
+type ResponseController interface {
+	// LockKeyboard leaves the keyboard of the terminal locked once the
+	// screen is displayed
+	LockKeyboard()
+	// Reject answers the request with a negative TN3270E response instead
+	// of a screen, whatever was written is discarded
+	Reject(code ResponseCode)
+}
+
+// ScreenWriter is implemented by the ResponseWriter of the Server and writes
+// 3270 orders between texts to build formatted screens. Screens are 80
+// columns wide.
+type ScreenWriter interface {
+	// SetBufferAddress moves the write position, rows and columns start at 0
+	SetBufferAddress(row, col int)
+	// StartField starts a field with the given attribute bits
+	StartField(attr byte)
+	// InsertCursor puts the cursor at the write position
+	InsertCursor()
+}
+
 type defaultResponseWriter struct {
 	headerWrote  bool
 	trailerWrote bool
 	buf          *bufio.ReadWriter
+	cp           *CodePage
+	wcc          byte
+	rejected     bool
+	code         ResponseCode
+	seq          uint16 // sequence number of the request
+	responses    bool   // RESPONSES is negotiated
 }
 
-func (w *defaultResponseWriter) Write(s []byte) (n int, e error) {
-	var n1 int
+func (w *defaultResponseWriter) writeHeader() {
 	if !w.headerWrote {
 		w.headerWrote = true
-		n1, e = w.buf.Write([]byte{0x00, 0x00, 0x00, 0x00, 0x00})
-		n += n1
-		n1, e = w.buf.Write([]byte{0xf5, 0xc3, 0x11, 0xc1, 0x50})
-		n += n1
+		w.buf.Write([]byte{0x00, 0x00, 0x00, 0x00, 0x00})
+		w.buf.Write([]byte{0xf5, w.wcc, 0x11, 0xc1, 0x50})
+	}
+}
+
+func (w *defaultResponseWriter) LockKeyboard() {
+	// Keyboard restore is bit 6 of the WCC
+	w.wcc &^= 0x02
+}
+
+func (w *defaultResponseWriter) Reject(code ResponseCode) {
+	w.rejected = true
+	w.code = code
+}
+
+func (w *defaultResponseWriter) writeOrder(order ...byte) {
+	if w.rejected {
+		return
+	}
+	w.writeHeader()
+	w.buf.Write(order)
+}
+
+func (w *defaultResponseWriter) SetBufferAddress(row, col int) {
+	w.writeOrder(append([]byte{0x11}, encodeAddr(row*80+col)...)...)
+}
+
+func (w *defaultResponseWriter) StartField(attr byte) {
+	w.writeOrder(0x1d, addrCodes[attr&0x3f])
+}
+
+func (w *defaultResponseWriter) InsertCursor() {
+	w.writeOrder(0x13)
+}
+
+func (w *defaultResponseWriter) Write(s []byte) (n int, e error) {
+	if w.rejected {
+		return len(s), nil
+	}
+	w.writeHeader()
+	_, e = w.buf.Write(w.cp.Encode(string(s)))
+	if e == nil {
+		n = len(s)
 	}
-	n1, e = w.buf.Write(A2E(s))
-	n += n1
 	return
 }
 
 func (w *defaultResponseWriter) finishRequest() {
+	if w.rejected && w.responses {
+		w.buf.Write(negativeResponse(w.seq, w.code))
+		w.buf.Flush()
+		return
+	}
+	if w.rejected {
+		// Without RESPONSES the terminal is only given its keyboard back,
+		// the screen is left unchanged
+		w.buf.Write([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0xf1, w.wcc | 0x02, 0xff, 0xef})
+		w.buf.Flush()
+		return
+	}
+	// Always send a screen, even an empty one
+	w.writeHeader()
 	w.buf.Write([]byte{0xff, 0xef})
 	w.buf.Flush()
 }
@@ -86,61 +204,341 @@ type Server struct {
 	Addr      string  // TCP address to listen on, ":telnet" if empty
 	Handler   Handler // handler to invoke
 	TLSConfig *tls.Config
-
-	t          tomb.Tomb // Manages the go routine spawned by the server
-	mu         sync.Mutex
-	inShutdown int32 // accessed atomically (non-zero means we're in Shutdown)
-	listeners  map[*net.Listener]struct{}
-	activeConn map[*conn]struct{}
+	LUPool    *LUPool   // LUs handed out to clients, any name is accepted if nil
+	CodePage  *CodePage // code page of the clients, CP037 if nil
+	// USSMessage is shown in the SSCP-LU session terminals start in, where
+	// they log on to an application with LOGON APPLID(name) before the
+	// welcome screen is served. The application is kept in
+	// Session.Application. There is no SSCP-LU session if empty.
+	USSMessage string
+	// Applications are the names accepted by LOGON APPLID, any if nil
+	Applications []string
+
+	// Functions are the TN3270E functions supported, RESPONSES if nil, and
+	// DATA-STREAM-CTL, RESPONSES and SCS-CTL-CODES for printers
+	Functions Functions
+
+	// Middleware wraps Handler, the first middleware is the outermost one
+	Middleware []Middleware
+
+	// Trace logs the decoded protocol events of every connection if not nil
+	Trace Logger
+
+	// StartTLS tells whether plain connections are upgraded to TLS with
+	// the START_TLS option, using TLSConfig, before TN3270E is negotiated
+	StartTLS StartTLSPolicy
+	// StartTLSTimeout is how long terminals have to answer DO START_TLS,
+	// 5 seconds if 0. Silent terminals are then handled as if they refused.
+	StartTLSTimeout time.Duration
+
+	// MapCertificate authorizes the terminals by their client certificate,
+	// which TLSConfig must request. Terminals are rejected at the device
+	// type negotiation if their certificate is missing or not mapped.
+	MapCertificate CertificateMapper
+
+	// Environ are the NEW-ENVIRON variables requested from the terminals,
+	// kept in Session.Environ. Terminals are not asked if nil, and all their
+	// variables are requested if empty. DEVNAME is the LU requested by
+	// terminals whose DEVICE-TYPE REQUEST does not name one.
+	Environ []string
+
+	// Options handle the telnet options the server does not support, they
+	// are refused otherwise. TN3270E, TERMINAL-TYPE, NEW-ENVIRON and
+	// START_TLS cannot be overridden.
+	Options map[byte]OptionHandler
+
+	track    tracker
+	mu       sync.Mutex
+	printers map[*conn]struct{} // printers ready for jobs, protected by mu
+	nextLU   uint32             // accessed atomically, numbers generic LUs without pool
 }
 
 type conn struct {
 	remoteAddr string            // network address of remote side
 	server     *Server           // the Server on which the connection arrived
+	handler    Handler           // server handler wrapped in its middlewares
 	rwc        net.Conn          // i/o connection
+	sw         *switchConn       // connection upgraded by START_TLS
+	secure     bool              // the connection uses TLS
+	tlsWait    bool              // waiting for the terminal to start TLS
+	upgrade    bool              // the terminal sent START_TLS FOLLOWS
 	lr         *io.LimitedReader // io.LimitReader(sr)
 	buf        *bufio.ReadWriter // buffered(lr,rwc)
 	parser     Parser
+	telnet     *negotiator
+	luname     string    // LU assigned by DEVICE-TYPE IS
+	deviceType string    // device type of the DEVICE-TYPE REQUEST
+	associate  string    // terminal named by the ASSOCIATE request
+	functions  Functions // TN3270E functions negotiated
+	seq        uint16    // sequence number of the last record received
+	session    Session
+
+	sscp bool       // the SSCP-LU session is active
+	wmu  sync.Mutex // serializes the writes to buf, printers get jobs from other connections
+}
+
+// write sends data to the terminal at once
+func (c *conn) write(data ...[]byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	for _, d := range data {
+		c.buf.Write(d)
+	}
+	return c.buf.Flush()
 }
 
 func (c *conn) serve() {
-	c.buf.Write([]byte{0xff, 0xfd, 0x28})
-	c.buf.Flush()
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("tn3270: panic serving %s: %v\n%s", c.remoteAddr, err, debug.Stack())
+			c.rwc.Close()
+		}
+	}()
+	defer c.releaseLU()
+	defer c.server.trackPrinter(c, false)
+	if c.server.StartTLS != StartTLSDisabled && !c.secure {
+		c.tlsWait = true
+		c.rwc.SetReadDeadline(time.Now().Add(durationOrDefault(c.server.StartTLSTimeout, 5*time.Second)))
+		c.telnet.enable(OptionStartTLS, false)
+	} else {
+		c.negotiate()
+	}
 	for {
 		recv_buf := make([]byte, 1024)
-		n, _ := c.buf.Read(recv_buf)
-		if n == 0 {
+		n, err := c.buf.Read(recv_buf)
+		if n > 0 {
+			c.parser.Parse(recv_buf[:n])
+		}
+		if err != nil && c.startTLSTimedOut(err) {
+			continue
+		}
+		if err != nil {
 			break
 		}
-		c.parser.Parse(recv_buf[:n])
+		if c.upgrade {
+			if err := c.startTLS(); err != nil {
+				log.Printf("tn3270: START_TLS with %s: %v", c.remoteAddr, err)
+				c.rwc.Close()
+				break
+			}
+		}
 	}
 }
 
+// negotiate asks the terminal for its environment variables, its terminal
+// type and TN3270E
+func (c *conn) negotiate() {
+	if c.server.Environ != nil {
+		c.telnet.enable(OptionNewEnviron, false)
+	}
+	c.telnet.enable(OptionTerminalType, false)
+	c.telnet.enable(OptionTN3270E, false)
+}
+
+// startTLS runs the TLS handshake once the terminal sent START_TLS FOLLOWS,
+// and negotiates the session again over TLS
+func (c *conn) startTLS() error {
+	c.upgrade = false
+	buffered := c.parser.(*telnetParser).resume()
+	if n := c.buf.Reader.Buffered(); n > 0 {
+		b, _ := c.buf.Reader.Peek(n)
+		buffered = append(buffered, b...)
+		c.buf.Reader.Discard(n)
+	}
+	if tc, ok := c.rwc.(*traceConn); ok {
+		tc.resync()
+	}
+	if err := c.sw.upgrade(nil, buffered, c.server.TLSConfig, true); err != nil {
+		return err
+	}
+	c.secure = true
+	c.tlsWait = false
+	c.rwc.SetReadDeadline(time.Time{})
+	c.telnet.reset()
+	c.telnet.allow(OptionTN3270E, false, true)
+	c.negotiate()
+	return nil
+}
+
+// startTLSTimedOut handles the terminals that do not start TLS in time as
+// if they refused START_TLS, it reports whether err was such a timeout
+func (c *conn) startTLSTimedOut(err error) bool {
+	if ne, ok := err.(net.Error); !ok || !ne.Timeout() || !c.tlsWait {
+		return false
+	}
+	c.rwc.SetReadDeadline(time.Time{})
+	c.telnet.disable(OptionStartTLS, false)
+	c.refuseStartTLS()
+	return true
+}
+
+// needsTLS reports whether the connection must be upgraded by START_TLS
+// before the terminal negotiates TN3270E or sends data
+func (c *conn) needsTLS() bool {
+	return c.server.StartTLS == StartTLSRequired && !c.secure
+}
+
+// ready reports whether the terminal got its LU, over TLS if TLS is
+// required. The data of the terminals not ready is dropped.
+func (c *conn) ready() bool {
+	return c.luname != "" && !c.needsTLS()
+}
+
+// refuseStartTLS goes on in plain text when the terminal refuses
+// START_TLS, unless TLS is required
+func (c *conn) refuseStartTLS() {
+	c.tlsWait = false
+	if c.server.StartTLS == StartTLSRequired {
+		log.Printf("tn3270: %s does not support START_TLS", c.remoteAddr)
+		c.rwc.Close()
+		return
+	}
+	c.negotiate()
+}
+
 func (c *conn) recv() {
 }
 
+// newResponseWriter returns the writer of a screen, c.wmu must be held until
+// the request is finished
+func (c *conn) newResponseWriter() *defaultResponseWriter {
+	return &defaultResponseWriter{
+		buf:       c.buf,
+		cp:        codePageOrDefault(c.server.CodePage),
+		wcc:       0xc3,
+		seq:       c.seq,
+		responses: c.functions.Has(FunctionResponses),
+	}
+}
+
+// assignLU picks the LU for a DEVICE-TYPE REQUEST. Only one of connect and
+// associate may be set, none for a generic request.
+func (c *conn) assignLU(deviceType, associate, connect string) (string, ReasonCode, bool) {
+	if !deviceTypes[deviceType] {
+		return "", ReasonInvDeviceType, false
+	}
+	if associate != "" && deviceType != "IBM-3287-1" {
+		return "", ReasonInvDeviceType, false
+	}
+	allowed, err := c.authorize()
+	if err != nil {
+		return "", rejectReason(err), false
+	}
+	switch {
+	case allowed != "" && associate != "":
+		// Printers may only associate with the LU of their certificate
+		if associate != allowed {
+			return "", rejectReason(ErrCertificateLU), false
+		}
+	case allowed != "":
+		if connect != "" && connect != allowed {
+			return "", rejectReason(ErrCertificateLU), false
+		}
+		connect = allowed
+	}
+	if connect == "" && associate == "" {
+		// The LU may be requested in the NEW-ENVIRON variables
+		connect = c.session.Environ["DEVNAME"]
+	}
+	c.releaseLU()
+	pool := c.server.LUPool
+	var name string
+	switch {
+	case pool != nil && associate != "":
+		name, err = pool.Associate(associate)
+	case pool != nil:
+		name, err = pool.Acquire(connect)
+	case associate != "":
+		err = ErrNoAssociation
+	case connect != "":
+		name = connect
+	default:
+		name = fmt.Sprintf("TERM%04d", atomic.AddUint32(&c.server.nextLU, 1))
+	}
+	if err != nil {
+		return "", rejectReason(err), false
+	}
+	c.luname = name
+	c.deviceType = deviceType
+	c.associate = associate
+	return name, 0, true
+}
+
+// releaseLU gives the LU of the connection back to the pool
+func (c *conn) releaseLU() {
+	if c.luname != "" && c.server.LUPool != nil {
+		c.server.LUPool.Release(c.luname)
+	}
+	c.luname = ""
+}
+
+// requestBuilder collects the AID, text and modified fields of the records
+// sent by a terminal
+type requestBuilder struct {
+	cp     *CodePage
+	text   []string
+	fields []Field
+	aid    AID
+}
+
+func (b *requestBuilder) OnTN3270Text(text []byte) {
+	s := b.cp.Decode(text)
+	b.text = append(b.text, s)
+	if len(b.fields) > 0 {
+		b.fields[len(b.fields)-1].Value += s
+	}
+}
+
+func (b *requestBuilder) OnTN3270AID(aid byte) {
+	b.aid = AID(aid)
+}
+
+func (b *requestBuilder) OnTN3270SBA(addr int) {
+	// Each modified field starts with a SBA
+	b.fields = append(b.fields, Field{Addr: addr})
+}
+
+// request returns the request collected so far and starts a new one
+func (b *requestBuilder) request() *Request {
+	r := &Request{
+		Text:   strings.Join(b.text, ""),
+		Fields: b.fields,
+		AID:    b.aid,
+	}
+	b.text = b.text[0:0]
+	b.fields = nil
+	return r
+}
+
 type defaultTNHandler struct {
-	c    *conn
-	text []string
+	requestBuilder
+	c *conn
 }
 
-func (*defaultTNHandler) OnTNCommand(byte) {
+func (h *defaultTNHandler) OnTNCommand(c byte) {
+	if c == 0xf5 { // AO is sent for SYSREQ
+		h.sysReq()
+	}
 }
 
 func (h *defaultTNHandler) OnTNArgCommand(c byte, a byte) {
-	if c == 0xfb && a == 0x28 { // WILL TN3270
-		h.c.buf.Write([]byte{0xff, 0xfa, 0x28, 0x08, 0x02, 0xff, 0xf0}) // SEND DEVICE TYPE
-	}
-	h.c.buf.Flush()
+	h.c.telnet.receive(c, a)
+}
+
+func (h *defaultTNHandler) OnTNSubnegotiation(option byte, data []byte) bool {
+	return h.c.telnet.subnegotiation(option, data)
 }
 
 func (h *defaultTNHandler) OnTN3270DeviceTypeRequest(device_type []byte, device_name []byte, resource_name []byte) {
-	h.c.buf.Write([]byte{0xff, 0xfa, 0x28, 0x02, 0x04})
-	h.c.buf.Write(device_type)
-	h.c.buf.Write([]byte{0x01})
-	h.c.buf.Write(resource_name)
-	h.c.buf.Write([]byte{0xff, 0xf0})
-	h.c.buf.Flush()
+	if h.c.needsTLS() {
+		return
+	}
+	name, reason, ok := h.c.assignLU(string(device_type), string(device_name), string(resource_name))
+	if ok {
+		h.c.write(deviceTypeIs(string(device_type), name))
+	} else {
+		h.c.write(deviceTypeReject(reason))
+	}
 }
 
 func (h *defaultTNHandler) OnTN3270DeviceTypeIs([]byte, []byte) {
@@ -155,15 +553,57 @@ func (h *defaultTNHandler) OnTN3270SendDeviceType() {
 	// Not applicable for servers
 }
 
-func (h *defaultTNHandler) OnTN3270FunctionsIs([]byte) {
-	w := &defaultResponseWriter{buf: h.c.buf}
-	h.c.server.Handler.ServeWelcomeScreen(w)
+func (h *defaultTNHandler) OnTN3270FunctionsIs(functions []byte) {
+	if !h.c.ready() {
+		return
+	}
+	h.c.functions = parseFunctions(functions)
+	h.welcome()
+}
+
+// OnTN3270FunctionsRequest agrees to the functions requested by the
+// terminal if they are all supported, and requests the supported ones
+// otherwise
+func (h *defaultTNHandler) OnTN3270FunctionsRequest(functions []byte) {
+	if !h.c.ready() {
+		return
+	}
+	agreed, answer := answerFunctions(parseFunctions(functions), h.c.server.supportedFunctions(h.c.isPrinter()))
+	h.c.write(answer)
+	if agreed == nil {
+		return
+	}
+	h.c.functions = agreed
+	h.welcome()
+}
+
+// welcome sends the welcome screen once the functions are negotiated,
+// printers get no screen and are made available to handlers instead
+func (h *defaultTNHandler) welcome() {
+	if h.c.isPrinter() {
+		h.c.server.trackPrinter(h.c, true)
+		return
+	}
+	if h.c.ussEnabled() {
+		h.c.sscp = true
+		h.c.writeSSCP(h.c.server.USSMessage)
+		return
+	}
+	h.serveWelcomeScreen()
+}
+
+func (h *defaultTNHandler) serveWelcomeScreen() {
+	h.c.wmu.Lock()
+	defer h.c.wmu.Unlock()
+	w := h.c.newResponseWriter()
+	h.c.handler.ServeWelcomeScreen(w)
 	w.finishRequest()
 }
 
-func (h *defaultTNHandler) OnTN3270FunctionsRequest([]byte) {
-	h.c.buf.Write([]byte{0xff, 0xfa, 0x28, 0x03, 0x07, 0xff, 0xf0})
-	h.c.buf.Flush()
+// OnTN3270Header keeps the sequence number of the request, negative
+// responses refer to it
+func (h *defaultTNHandler) OnTN3270Header(dataType byte, responseFlag byte, seq uint16) {
+	h.c.seq = seq
 }
 
 func (h *defaultTNHandler) OnError([]byte, int) error {
@@ -174,18 +614,10 @@ func (h *defaultTNHandler) OnTN3270Command(byte) {
 	// Not applicable for servers
 }
 
-func (h *defaultTNHandler) OnTN3270Text(text []byte) {
-	h.text = append(h.text, string(E2A(text)))
-}
-
 func (h *defaultTNHandler) OnTN3270WCC(byte) {
 	// Not applicable for servers
 }
 
-func (h *defaultTNHandler) OnTN3270AID(byte) {
-	// Not applicable for servers
-}
-
 func (h *defaultTNHandler) OnTN3270PT() {
 	// Not applicable for servers
 }
@@ -206,36 +638,105 @@ func (h *defaultTNHandler) OnTN3270RA(int, byte) {
 	// Not applicable for servers
 }
 
-func (h *defaultTNHandler) OnTN3270SBA(int) {
-	// Not applicable for servers
-}
-
 func (h *defaultTNHandler) OnTN3270EUA(int) {
 	// Not applicable for servers
 }
 
+// OnTN3270Message serves the requests of the LU-LU session, the ones sent
+// while the SSCP-LU session is active or before the terminal got its LU
+// are dropped
 func (h *defaultTNHandler) OnTN3270Message() {
-	w := &defaultResponseWriter{buf: h.c.buf}
-	h.c.server.Handler.ServeTN3270(w, &Request{Text: strings.Join(h.text, "")})
-	h.text = h.text[0:0]
+	if h.c.sscp || !h.c.ready() {
+		h.request()
+		return
+	}
+	h.c.wmu.Lock()
+	defer h.c.wmu.Unlock()
+	w := h.c.newResponseWriter()
+	r := h.request()
+	r.LUName = h.c.luname
+	r.Session = &h.c.session
+	r.Functions = h.c.functions
+	r.server = h.c.server
+	h.c.handler.ServeTN3270(w, r)
 	w.finishRequest()
 }
 
+func (s *Server) supportedFunctions(printer bool) Functions {
+	if s.Functions == nil && printer {
+		return Functions{FunctionDataStreamCtl, FunctionResponses, FunctionSCSCtlCodes}
+	}
+	if s.Functions == nil {
+		return Functions{FunctionResponses}
+	}
+	return s.Functions
+}
+
 func (s *Server) newConn(rwc net.Conn) *conn {
 	c := new(conn)
 	c.remoteAddr = rwc.RemoteAddr().String()
 	c.server = s
-	c.rwc = rwc
-	h := &defaultTNHandler{c: c, text: make([]string, 0)}
-	c.parser = NewParser(h, h, h, h)
-
-	if debugServerConnections {
-		c.rwc = newLoggingConn("server", c.rwc)
+	c.handler = Chain(s.Handler, s.Middleware...)
+	c.secure = isTLS(rwc)
+	c.sw = &switchConn{conn: rwc}
+	c.rwc = c.sw
+	h := &defaultTNHandler{c: c}
+	h.cp = codePageOrDefault(s.CodePage)
+	c.parser = newInboundParser(h, h, h, h)
+
+	if s.Trace != nil {
+		c.rwc = newTraceConn(c.rwc, s.Trace, c.remoteAddr+" ", s.CodePage, true)
 	}
 	c.lr = io.LimitReader(c.rwc, noLimit).(*io.LimitedReader)
 	br := bufio.NewReader(c.lr)
 	bw := bufio.NewWriter(c.rwc)
 	c.buf = bufio.NewReadWriter(br, bw)
+	c.telnet = newNegotiator(func(data []byte) {
+		c.write(data)
+	}, map[byte]OptionHandler{
+		OptionTN3270E: {Remote: !c.needsTLS(), OnChange: func(local bool, enabled bool) {
+			if enabled {
+				c.write([]byte{0xff, 0xfa, 0x28, 0x08, 0x02, 0xff, 0xf0}) // SEND DEVICE TYPE
+			}
+		}},
+		OptionTerminalType: {Remote: true, OnChange: func(local bool, enabled bool) {
+			if enabled {
+				c.telnet.subnegotiate(OptionTerminalType, []byte{subnegSEND})
+			}
+		}, OnSubnegotiation: func(data []byte) []byte {
+			if len(data) > 0 && data[0] == subnegIS {
+				c.session.TerminalType = string(data[1:])
+			}
+			return nil
+		}},
+		OptionStartTLS: {Remote: s.StartTLS != StartTLSDisabled && !c.secure, OnChange: func(local bool, enabled bool) {
+			switch {
+			case c.secure || !c.tlsWait:
+				// START_TLS is over once the connection uses TLS, or
+				// once the terminal failed to answer in time
+			case enabled:
+				c.telnet.subnegotiate(OptionStartTLS, []byte{startTLSFollows})
+			default:
+				c.refuseStartTLS()
+			}
+		}, OnSubnegotiation: func(data []byte) []byte {
+			if isStartTLSFollows(data) && !c.secure && c.telnet.enabled(OptionStartTLS, false) {
+				c.upgrade = true
+				c.parser.(*telnetParser).suspend()
+			}
+			return nil
+		}},
+		OptionNewEnviron: {Remote: s.Environ != nil, OnChange: func(local bool, enabled bool) {
+			if enabled {
+				c.telnet.subnegotiate(OptionNewEnviron, environSend(s.Environ))
+			}
+		}, OnSubnegotiation: func(data []byte) []byte {
+			if len(data) > 0 && (data[0] == subnegIS || data[0] == subnegINFO) {
+				c.session.setEnviron(parseEnviron(data[1:]))
+			}
+			return nil
+		}},
+	}, s.Options)
 	return c
 }
 
@@ -243,71 +744,26 @@ func (s *Server) newConn(rwc net.Conn) *conn {
 // calls Serve to handle requests on incoming connections.  If
 // s.Addr is blank, ":telnet" is used.
 func (s *Server) ListenAndServe() error {
-	addr := s.Addr
-	if addr == "" {
-		addr = ":telnet"
-	}
-	l, err := net.Listen("tcp", addr)
-	if err != nil {
-		return err
-	}
-	return s.Serve(l)
-}
-
-func (s *Server) shuttingDown() bool {
-	// TODO: replace inShutdown with the existing atomicBool type;
-	// see https://github.com/golang/go/issues/20239#issuecomment-381434582
-	return atomic.LoadInt32(&s.inShutdown) != 0
+	return s.track.listenAndServe(s.Addr, ":telnet", s.Serve)
 }
 
+// ListenAndServeTLS is like ListenAndServe but accepts TLS connections. If
+// s.Addr is blank, ":https" is used.
 func (s *Server) ListenAndServeTLS(certFile, keyFile string) error {
-	if s.shuttingDown() {
-		return errors.New("Server closed")
-	}
-	addr := s.Addr
-	if addr == "" {
-		addr = ":https"
-	}
-
-	ln, err := net.Listen("tcp", addr)
-	if err != nil {
-		return err
-	}
-
-	defer ln.Close()
-
-	return s.ServeTLS(ln, certFile, keyFile)
-}
-
-// cloneTLSConfig returns a shallow clone of cfg, or a new zero tls.Config if
-// cfg is nil. This is safe to call even if cfg is in active use by a TLS
-// client or server.
-func cloneTLSConfig(cfg *tls.Config) *tls.Config {
-	if cfg == nil {
-		return &tls.Config{}
-	}
-	return cfg.Clone()
+	return s.track.listenAndServe(s.Addr, ":https", func(l net.Listener) error {
+		return s.ServeTLS(l, certFile, keyFile)
+	})
 }
 
 // ServeTLS accepts incoming connections on the Listener l, creating a
 // new service goroutine for each. The service goroutines perform TLS
 // setup and then read requests, calling srv.Handler to reply to them.
 func (srv *Server) ServeTLS(l net.Listener, certFile, keyFile string) error {
-
-	config := cloneTLSConfig(srv.TLSConfig)
-
-	configHasCert := len(config.Certificates) > 0 || config.GetCertificate != nil
-	if !configHasCert || certFile != "" || keyFile != "" {
-		var err error
-		config.Certificates = make([]tls.Certificate, 1)
-		config.Certificates[0], err = tls.LoadX509KeyPair(certFile, keyFile)
-		if err != nil {
-			return err
-		}
+	tl, err := tlsListener(l, srv.TLSConfig, certFile, keyFile)
+	if err != nil {
+		return err
 	}
-
-	tlsListener := tls.NewListener(l, config)
-	return srv.Serve(tlsListener)
+	return srv.Serve(tl)
 }
 
 var ErrServerClosed = errors.New("Server closed")
@@ -316,125 +772,22 @@ var ErrServerClosed = errors.New("Server closed")
 // new service goroutine for each.  The service goroutines read requests and
 // then call s.Handler to reply to them.
 func (s *Server) Serve(l net.Listener) error {
-	defer l.Close()
-
-	if !s.trackListener(&l, true) {
-		return ErrServerClosed
-	}
-	defer s.trackListener(&l, false)
-
-	for {
-		rw, err := l.Accept()
-		if err != nil {
-			select {
-			case <-s.t.Dying():
-				return nil
-			default:
-			}
-			return err
-		}
-		c := s.newConn(rw)
-		s.t.Go(func() error {
-			c.serve()
-			return nil
-		})
-	}
-}
-
-// trackListener adds or removes a net.Listener to the set of tracked
-// listeners.
-//
-// We store a pointer to interface in the map set, in case the
-// net.Listener is not comparable. This is safe because we only call
-// trackListener via Serve and can track+defer untrack the same
-// pointer to local variable there. We never need to compare a
-// Listener from another caller.
-//
-// It reports whether the server is still up (not Shutdown or Closed).
-func (s *Server) trackListener(ln *net.Listener, add bool) bool {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if s.listeners == nil {
-		s.listeners = make(map[*net.Listener]struct{})
-	}
-	if add {
-		if s.shuttingDown() {
-			return false
-		}
-		s.listeners[ln] = struct{}{}
-	} else {
-		delete(s.listeners, ln)
-	}
-	return true
+	return s.track.serve(l, s.ServeConn)
 }
 
-func (s *Server) trackConn(c *conn, add bool) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	if s.activeConn == nil {
-		s.activeConn = make(map[*conn]struct{})
-	}
-	if add {
-		s.activeConn[c] = struct{}{}
-	} else {
-		delete(s.activeConn, c)
+// ServeConn serves a single connection, such as one end of a net.Pipe, and
+// returns once it is closed.
+func (s *Server) ServeConn(rwc net.Conn) {
+	c := s.newConn(rwc)
+	if !s.track.trackConn(c, func() { c.rwc.Close() }) {
+		return
 	}
+	defer s.track.untrackConn(c)
+	c.serve()
 }
 
-func (s *Server) closeListenersLocked() error {
-	var err error
-	for ln := range s.listeners {
-		if cerr := (*ln).Close(); cerr != nil && err == nil {
-			err = cerr
-		}
-		delete(s.listeners, ln)
-	}
-	return err
-}
-
+// Close closes the listeners and the connections of the server, then waits
+// for the connections accepted by Serve to be done
 func (s *Server) Close() error {
-	atomic.StoreInt32(&s.inShutdown, 1)
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.t.Kill(nil)
-	err := s.closeListenersLocked()
-	for c := range s.activeConn {
-		c.rwc.Close()
-		delete(s.activeConn, c)
-	}
-	return err
-}
-
-// loggingConn is used for debugging.
-type loggingConn struct {
-	name string
-	net.Conn
-}
-
-func newLoggingConn(baseName string, c net.Conn) net.Conn {
-	return &loggingConn{
-		name: fmt.Sprintf("%s", baseName),
-		Conn: c,
-	}
-}
-
-func (c *loggingConn) Write(p []byte) (n int, err error) {
-	log.Printf("%s.Write(%d) = %x", c.name, len(p), p)
-	n, err = c.Conn.Write(p)
-	log.Printf("%s.Write(%d) = %d, %v", c.name, len(p), n, err)
-	return
-}
-
-func (c *loggingConn) Read(p []byte) (n int, err error) {
-	log.Printf("%s.Read(%d) = ....", c.name, len(p))
-	n, err = c.Conn.Read(p)
-	log.Printf("%s.Read(%d) = %d, %v", c.name, len(p), n, err)
-	return
-}
-
-func (c *loggingConn) Close() (err error) {
-	log.Printf("%s.Close() = ...", c.name)
-	err = c.Conn.Close()
-	log.Printf("%s.Close() = %v", c.name, err)
-	return
+	return s.track.close()
 }
@@ -0,0 +1,151 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// StartTLSPolicy tells whether a plain telnet connection is upgraded to TLS
+// with the START_TLS option. Connections already using TLS are never
+// upgraded.
+type StartTLSPolicy int
+
+const (
+	// StartTLSDisabled refuses START_TLS
+	StartTLSDisabled StartTLSPolicy = iota
+	// StartTLSOptional upgrades the connection if the peer supports
+	// START_TLS, and goes on in plain text otherwise
+	StartTLSOptional
+	// StartTLSRequired ends the connection if the peer does not support
+	// START_TLS
+	StartTLSRequired
+)
+
+// ErrTLSRequired is returned by clients requiring START_TLS when the host
+// negotiates the session without it
+var ErrTLSRequired = errors.New("tn3270: host did not negotiate START_TLS")
+
+// startTLSFollows is the only command of the START_TLS subnegotiation
+const startTLSFollows = 0x01
+
+// isTLS reports whether conn already uses TLS
+func isTLS(conn net.Conn) bool {
+	_, ok := conn.(*tls.Conn)
+	return ok
+}
+
+// switchConn is a connection upgraded to TLS in place by START_TLS. It is
+// wrapped by the recorders and the traces, which keep seeing plain text.
+// It is read by one goroutine, the one upgrading it.
+type switchConn struct {
+	wmu  sync.Mutex // held by writes and the upgrade
+	mu   sync.Mutex // protects conn
+	conn net.Conn
+}
+
+func (c *switchConn) current() net.Conn {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn
+}
+
+func (c *switchConn) Read(p []byte) (int, error) {
+	return c.current().Read(p)
+}
+
+func (c *switchConn) Write(p []byte) (int, error) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return c.current().Write(p)
+}
+
+func (c *switchConn) Close() error {
+	return c.current().Close()
+}
+
+func (c *switchConn) LocalAddr() net.Addr {
+	return c.current().LocalAddr()
+}
+
+func (c *switchConn) RemoteAddr() net.Addr {
+	return c.current().RemoteAddr()
+}
+
+func (c *switchConn) SetDeadline(t time.Time) error {
+	return c.current().SetDeadline(t)
+}
+
+func (c *switchConn) SetReadDeadline(t time.Time) error {
+	return c.current().SetReadDeadline(t)
+}
+
+func (c *switchConn) SetWriteDeadline(t time.Time) error {
+	return c.current().SetWriteDeadline(t)
+}
+
+// prefixConn is a connection whose first bytes were already read
+type prefixConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *prefixConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
+
+// upgrade sends last in plain text, then runs the TLS handshake on the
+// connection. Clients send their FOLLOWS as last, servers hand the data of
+// the client read past its FOLLOWS as buffered.
+func (c *switchConn) upgrade(last []byte, buffered []byte, config *tls.Config, server bool) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	plain := c.current()
+	if len(last) > 0 {
+		if _, err := plain.Write(last); err != nil {
+			return err
+		}
+	}
+	var conn *tls.Conn
+	if server {
+		r := io.MultiReader(bytes.NewReader(buffered), plain)
+		conn = tls.Server(&prefixConn{Conn: plain, r: r}, config)
+	} else {
+		conn = tls.Client(plain, config)
+	}
+	if err := conn.Handshake(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.conn = conn
+	c.mu.Unlock()
+	return nil
+}
+
+// startTLSFollowsSubneg is the IAC SB START_TLS FOLLOWS IAC SE
+// subnegotiation
+var startTLSFollowsSubneg = []byte{tnIAC, tnSB, OptionStartTLS, startTLSFollows, tnIAC, tnSE}
+
+// isStartTLSFollows reports whether the parameters of a START_TLS
+// subnegotiation are FOLLOWS
+func isStartTLSFollows(data []byte) bool {
+	return len(data) == 1 && data[0] == startTLSFollows
+}
@@ -0,0 +1,246 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"bytes"
+)
+
+// telnetParser splits the incoming telnet stream into commands,
+// subnegotiations and records before anything reaches the data stream
+// parser.
+//
+// Subnegotiations are decoded here rather than in the Ragel grammar so that
+// forms the grammar does not describe (generic DEVICE-TYPE REQUEST, all the
+// REJECT reasons, ...) can still be handled. Only complete commands and
+// complete records are forwarded, which also means the data stream parser
+// never sees a record split across two reads.
+//
+// Inbound parsers (used by servers) decode records themselves, see
+// parseInbound.
+// maxPending bounds the data kept for a command, subnegotiation or record
+// that is not complete yet
+const maxPending = 1 << 20
+
+type telnetParser struct {
+	next        *parser
+	tn3270negoh TN3270NegoHandler
+	errorh      ErrorHandler
+	inbound     bool
+	nvt         bool // in NVT mode, see NVTHandler
+	suspended   bool // stop parsing after the current unit, see suspend
+	pending     []byte
+}
+
+func (t *telnetParser) Parse(data []byte) error {
+	var err error
+	buf := data
+	if len(t.pending) > 0 {
+		buf = append(t.pending, data...)
+	}
+	i := 0
+	for i < len(buf) {
+		n, e := t.parseUnit(buf[i:])
+		if e != nil && err == nil {
+			err = e
+		}
+		if n == 0 {
+			break
+		}
+		i += n
+		if t.suspended {
+			break
+		}
+	}
+	if !t.suspended && len(buf)-i > maxPending {
+		// A unit that never ends, drop it rather than keep it growing
+		if e := t.errorh.OnError(buf[i:], 0); err == nil {
+			err = e
+		}
+		t.pending = t.pending[:0]
+		return err
+	}
+	// Keep whatever is left for the next call
+	t.pending = append(t.pending[:0], buf[i:]...)
+	return err
+}
+
+// suspend stops the parsing after the unit being handled, the data left is
+// kept until resume. It is used when the rest of the stream is not telnet,
+// such as the TLS handshake following START_TLS.
+func (t *telnetParser) suspend() {
+	t.suspended = true
+}
+
+// leaveNVT ends NVT mode, once the handler enabled an option of 3270 mode
+func (t *telnetParser) leaveNVT() {
+	t.nvt = false
+}
+
+// resume returns the data left since suspend and parses again
+func (t *telnetParser) resume() []byte {
+	rest := append([]byte(nil), t.pending...)
+	t.pending = t.pending[:0]
+	t.suspended = false
+	return rest
+}
+
+// parseUnit handles the command, subnegotiation or record at the beginning
+// of buf and returns the number of bytes consumed, or 0 if buf does not hold
+// a complete unit yet.
+func (t *telnetParser) parseUnit(buf []byte) (int, error) {
+	if t.nvt && (buf[0] != 0xff || len(buf) > 1 && buf[1] == 0xff) {
+		switch n := nvtLength(buf); n {
+		case -1:
+			// A record without negotiation, 3270 mode is assumed
+			t.nvt = false
+		case 0:
+			return 0, nil
+		default:
+			t.tn3270negoh.(NVTHandler).OnNVTData(unescapeIAC(buf[:n]))
+			return n, nil
+		}
+	}
+	n := unitLength(buf)
+	switch {
+	case n == 0:
+		return 0, nil
+	case buf[0] != 0xff || buf[1] == 0xff:
+		return n, t.record(buf[:n])
+	case buf[1] == 0xfa: // SB
+		return n, t.subnegotiation(unescapeIAC(buf[2 : n-2]))
+	}
+	return n, t.next.Parse(buf[:n])
+}
+
+// unitLength returns the length of the command, subnegotiation or record
+// at the beginning of buf, or 0 if buf does not hold a complete unit yet.
+// Records end with IAC EOR, subnegotiations with IAC SE.
+func unitLength(buf []byte) int {
+	if buf[0] != 0xff || (len(buf) > 1 && buf[1] == 0xff) {
+		// Data, read up to the end of record
+		end := findIAC(buf, 0xef)
+		if end == -1 {
+			return 0
+		}
+		return end + 2
+	}
+	if len(buf) < 2 {
+		return 0
+	}
+	switch buf[1] {
+	case 0xfa: // SB
+		end := findIAC(buf[2:], 0xf0)
+		if end == -1 {
+			return 0
+		}
+		return end + 4
+	case 0xfb, 0xfc, 0xfd, 0xfe: // WILL, WONT, DO, DONT
+		if len(buf) < 3 {
+			return 0
+		}
+		return 3
+	}
+	return 2
+}
+
+// record handles a record, TN3270E header and IAC EOR included
+func (t *telnetParser) record(data []byte) error {
+	if len(data) > 7 && data[0] == dataTypeResponse {
+		parseResponse(unescapeIAC(data[:len(data)-2]), t.tn3270negoh)
+		return nil
+	}
+	if hh, ok := t.tn3270negoh.(TN3270HeaderHandler); ok && len(data) >= 7 {
+		hh.OnTN3270Header(data[0], data[2], uint16(data[3])<<8|uint16(data[4]))
+	}
+	if nh, ok := t.tn3270negoh.(NVTHandler); ok && len(data) >= 7 && data[0] == dataTypeNVT {
+		nh.OnNVTData(unescapeIAC(data[5 : len(data)-2]))
+		return nil
+	}
+	if sh, ok := t.tn3270negoh.(TN3270SSCPHandler); ok && len(data) >= 7 && data[0] == dataTypeSSCPLU {
+		sh.OnTN3270SSCPLUData(unescapeIAC(data[5 : len(data)-2]))
+		return nil
+	}
+	if t.inbound {
+		if len(data) > 7 {
+			parseInbound(unescapeIAC(data[5:len(data)-2]), t.next.tn3270h)
+		}
+		return nil
+	}
+	if bh, ok := t.tn3270negoh.(TN3270BindHandler); ok && len(data) >= 7 {
+		switch data[0] {
+		case dataTypeBindImage:
+			bh.OnTN3270Bind(unescapeIAC(data[5 : len(data)-2]))
+			return nil
+		case dataTypeUnbind:
+			bh.OnTN3270Unbind(unescapeIAC(data[5 : len(data)-2]))
+			return nil
+		}
+	}
+	if ph, ok := t.tn3270negoh.(TN3270PrinterHandler); ok && len(data) >= 7 {
+		switch data[0] {
+		case dataTypeSCS:
+			ph.OnTN3270SCS(unescapeIAC(data[5 : len(data)-2]))
+			return nil
+		case dataTypePrintEOJ:
+			ph.OnTN3270PrintEOJ()
+			return nil
+		}
+	}
+	return t.next.Parse(data)
+}
+
+func (t *telnetParser) subnegotiation(data []byte) error {
+	if len(data) == 0 {
+		return t.errorh.OnError(data, 0)
+	}
+	switch data[0] {
+	case 0x28: // TN3270E
+		if !parseTN3270ESubneg(data[1:], t.tn3270negoh) {
+			return t.errorh.OnError(data, 0)
+		}
+		return nil
+	}
+	if sh, ok := t.next.tnh.(TNSubnegotiationHandler); ok && sh.OnTNSubnegotiation(data[0], data[1:]) {
+		return nil
+	}
+	return t.errorh.OnError(data, 0)
+}
+
+// findIAC returns the position of the first IAC cmd sequence of buf,
+// skipping escaped IAC IAC pairs, or -1 if there is none.
+func findIAC(buf []byte, cmd byte) int {
+	for i := 0; i+1 < len(buf); i++ {
+		if buf[i] != 0xff {
+			continue
+		}
+		if buf[i+1] == cmd {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// unescapeIAC replaces doubled IAC bytes by a single one.
+func unescapeIAC(data []byte) []byte {
+	return bytes.Replace(data, []byte{0xff, 0xff}, []byte{0xff}, -1)
+}
+
+// escapeIAC doubles IAC bytes so that data can be sent in a subnegotiation
+// or a record.
+func escapeIAC(data []byte) []byte {
+	return bytes.Replace(data, []byte{0xff}, []byte{0xff, 0xff}, -1)
+}
@@ -0,0 +1,24 @@
+package tn3270_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+var _ = Describe("Telnet parser", func() {
+	It("Should drop the units that never end", func() {
+		screen := tn3270.NewVirtualScreenTN3270Handler(24, 80)
+		p := tn3270.NewParser(nopHandler{}, nopHandler{}, screen, &tn3270.VerboseErrorHandler{})
+		Expect(p.Parse([]byte{0xff, 0xfa, 0x18})).To(Succeed())
+		Expect(p.Parse(bytes.Repeat([]byte{0x40}, 1<<20))).NotTo(Succeed())
+		Expect(p.Parse([]byte{
+			0x00, 0x00, 0x00, 0x00, 0x00, 0xf5, 0xc3, 0x11, 0x40, 0x40,
+			0xc8, 0xc9, 0xff, 0xef, // HI
+		})).To(Succeed())
+		Expect(screen.String()).To(Equal("HI"))
+	})
+})
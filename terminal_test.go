@@ -0,0 +1,106 @@
+package tn3270_test
+
+import (
+	"io"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+	"github.com/wuzuf/go-tn3270/tn3270test"
+)
+
+var _ = Describe("Terminal", func() {
+	var host *tn3270test.Host
+	var client *tn3270.Client
+
+	BeforeEach(func() {
+		host = tn3270test.NewHost(
+			tn3270test.Step{Write: tn3270test.LogonScreen},
+			tn3270test.Step{
+				Expect: &tn3270test.Input{AID: tn3270.PF(3), Fields: map[int]string{11: "JDOE", 31: "SECRET"}},
+				Screen: "WELCOME",
+			},
+		)
+		client = tn3270.NewClient("")
+		recv, err := client.ConnectConn(host.Pipe())
+		Expect(err).To(Succeed())
+		<-recv
+	})
+
+	AfterEach(func() {
+		client.Close()
+		host.Close()
+	})
+
+	It("Should decode fields", func() {
+		screen := client.Screen()
+		Expect(screen.Formatted()).To(BeTrue())
+		Expect(screen.KeyboardLocked()).To(BeFalse())
+		Expect(screen.Cursor()).To(Equal(11))
+		fields := screen.Fields()
+		Expect(fields).To(HaveLen(5))
+		Expect(fields[0].Protected()).To(BeTrue())
+		Expect(fields[0].Value).To(HavePrefix("USER"))
+		Expect(fields[1].Addr).To(Equal(11))
+		Expect(fields[1].Protected()).To(BeFalse())
+		Expect(fields[3].Hidden()).To(BeTrue())
+		Expect(screen.Protected(0)).To(BeTrue())
+		Expect(screen.Protected(11)).To(BeFalse())
+	})
+
+	It("Should type in unprotected fields and send them with an AID", func() {
+		Expect(client.Type("JDOE")).To(Succeed())
+		client.Tab()
+		Expect(client.Screen().Cursor()).To(Equal(31))
+		Expect(client.Type("SECRET")).To(Succeed())
+		Expect(client.Screen().Fields()[1].Modified()).To(BeTrue())
+		client.MoveCursor(0, 2)
+		Expect(client.Type("X")).To(Equal(tn3270.ErrProtected))
+		Expect(<-client.SendAID(tn3270.PF(3))).To(Equal("WELCOME"))
+		Expect(host.Err()).To(Succeed())
+		Expect(client.Screen().Formatted()).To(BeFalse())
+	})
+
+	It("Should erase to the end of the field", func() {
+		Expect(client.Type("JDOEX")).To(Succeed())
+		client.MoveCursor(0, 15)
+		Expect(client.EraseEOF()).To(Succeed())
+		Expect(client.Screen().Fields()[1].Value).To(Equal("JDOE     "))
+		client.MoveCursor(0, 0)
+		Expect(client.EraseEOF()).To(Equal(tn3270.ErrProtected))
+	})
+
+	It("Should parse key names", func() {
+		aid, ok := tn3270.ParseAID("pf12")
+		Expect(ok).To(BeTrue())
+		Expect(aid).To(Equal(tn3270.AIDPF12))
+		aid, _ = tn3270.ParseAID("Clear")
+		Expect(aid).To(Equal(tn3270.AIDClear))
+		_, ok = tn3270.ParseAID("PF25")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should lock the keyboard until the host answers", func() {
+		answer := make(chan struct{})
+		slow := tn3270test.NewHost(
+			tn3270test.Step{Write: tn3270test.LogonScreen},
+			tn3270test.Step{Expect: &tn3270test.Input{AID: tn3270.AIDClear}, Write: func(w tn3270.ResponseWriter) {
+				<-answer
+				io.WriteString(w, "CLEARED")
+			}},
+		)
+		defer slow.Close()
+		slowClient := tn3270.NewClient("")
+		defer slowClient.Close()
+		recv, err := slowClient.ConnectConn(slow.Pipe())
+		Expect(err).To(Succeed())
+		<-recv
+		recv = slowClient.SendAID(tn3270.AIDClear)
+		Expect(slowClient.Type("JDOE")).To(Equal(tn3270.ErrKeyboardLocked))
+		close(answer)
+		Expect(<-recv).To(Equal("CLEARED"))
+		Expect(slowClient.Screen().KeyboardLocked()).To(BeFalse())
+		Expect(slow.Err()).To(Succeed())
+	})
+})
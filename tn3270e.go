@@ -0,0 +1,359 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ReasonCode is the reason sent with a TN3270E DEVICE-TYPE REJECT (RFC 2355)
+type ReasonCode byte
+
+const (
+	ReasonConnPartner    ReasonCode = 0x00
+	ReasonDeviceInUse    ReasonCode = 0x01
+	ReasonInvAssociate   ReasonCode = 0x02
+	ReasonInvName        ReasonCode = 0x03
+	ReasonInvDeviceType  ReasonCode = 0x04
+	ReasonTypeNameError  ReasonCode = 0x05
+	ReasonUnknownError   ReasonCode = 0x06
+	ReasonUnsupportedReq ReasonCode = 0x07
+)
+
+var reasonNames = map[ReasonCode]string{
+	ReasonConnPartner:    "CONN-PARTNER",
+	ReasonDeviceInUse:    "DEVICE-IN-USE",
+	ReasonInvAssociate:   "INV-ASSOCIATE",
+	ReasonInvName:        "INV-NAME",
+	ReasonInvDeviceType:  "INV-DEVICE-TYPE",
+	ReasonTypeNameError:  "TYPE-NAME-ERROR",
+	ReasonUnknownError:   "UNKNOWN-ERROR",
+	ReasonUnsupportedReq: "UNSUPPORTED-REQ",
+}
+
+func (r ReasonCode) String() string {
+	if name, ok := reasonNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("REASON(0x%02x)", byte(r))
+}
+
+// NegotiationError is returned when the host rejects the device type
+// requested by a client
+type NegotiationError struct {
+	LU     string // LU requested, empty for a generic LU
+	Reason ReasonCode
+}
+
+func (e *NegotiationError) Error() string {
+	if e.LU == "" {
+		return fmt.Sprintf("tn3270: device type rejected: %s", e.Reason)
+	}
+	return fmt.Sprintf("tn3270: device type rejected for LU %s: %s", e.LU, e.Reason)
+}
+
+// TN3270E data types, first byte of the header of each record
+const (
+	dataType3270      = 0x00
+	dataTypeSCS       = 0x01
+	dataTypeResponse  = 0x02
+	dataTypeBindImage = 0x03
+	dataTypeUnbind    = 0x04
+	dataTypeNVT       = 0x05
+	dataTypeRequest   = 0x06
+	dataTypeSSCPLU    = 0x07
+	dataTypePrintEOJ  = 0x08
+)
+
+// ResponseCode is the data of a negative TN3270E RESPONSE message
+type ResponseCode byte
+
+const (
+	ResponseCommandReject         ResponseCode = 0x00
+	ResponseInterventionRequired  ResponseCode = 0x01
+	ResponseOperationCheck        ResponseCode = 0x02
+	ResponseComponentDisconnected ResponseCode = 0x03
+)
+
+var responseNames = map[ResponseCode]string{
+	ResponseCommandReject:         "COMMAND-REJECT",
+	ResponseInterventionRequired:  "INTERVENTION-REQUIRED",
+	ResponseOperationCheck:        "OPERATION-CHECK",
+	ResponseComponentDisconnected: "COMPONENT-DISCONNECTED",
+}
+
+func (r ResponseCode) String() string {
+	if name, ok := responseNames[r]; ok {
+		return name
+	}
+	return fmt.Sprintf("RESPONSE(0x%02x)", byte(r))
+}
+
+// ResponseError is a negative TN3270E response
+type ResponseError struct {
+	Seq  uint16 // sequence number of the rejected message
+	Code ResponseCode
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("tn3270: negative response to message %d: %s", e.Seq, e.Code)
+}
+
+// TN3270ResponseHandler is implemented by negotiation handlers interested in
+// the TN3270E RESPONSE messages of the peer. err is nil for positive
+// responses.
+type TN3270ResponseHandler interface {
+	OnTN3270Response(seq uint16, err *ResponseError)
+}
+
+// TN3270HeaderHandler is implemented by negotiation handlers interested in
+// the TN3270E headers. OnTN3270Header is called with the header of each
+// record of the peer, but RESPONSE messages, before the record is handled.
+type TN3270HeaderHandler interface {
+	OnTN3270Header(dataType byte, responseFlag byte, seq uint16)
+}
+
+// TN3270PrinterHandler is implemented by the negotiation handlers of
+// printer sessions. OnTN3270SCS is called with the data of SCS-DATA records
+// and OnTN3270PrintEOJ at the end of each print job.
+type TN3270PrinterHandler interface {
+	TN3270HeaderHandler
+	OnTN3270SCS([]byte)
+	OnTN3270PrintEOJ()
+}
+
+// TN3270BindHandler is implemented by negotiation handlers interested in
+// the BIND and UNBIND requests sent by hosts once BIND-IMAGE is negotiated.
+// They are called with the request units, without TN3270E header.
+type TN3270BindHandler interface {
+	OnTN3270Bind([]byte)
+	OnTN3270Unbind([]byte)
+}
+
+// TN3270SSCPHandler is implemented by negotiation handlers interested in
+// the SSCP-LU-DATA records, exchanged while the SSCP-LU session is active.
+// It is called with the data of the records, without TN3270E header.
+type TN3270SSCPHandler interface {
+	OnTN3270SSCPLUData([]byte)
+}
+
+// responseFlagAlways is the response flag of the records the host wants a
+// response to, whatever their outcome
+const responseFlagAlways = 0x02
+
+// positiveResponse returns a RESPONSE message accepting message seq
+func positiveResponse(seq uint16) []byte {
+	return []byte{dataTypeResponse, 0x00, 0x00, byte(seq >> 8), byte(seq), 0x00, 0xff, 0xef}
+}
+
+// negativeResponse returns a RESPONSE message rejecting message seq
+func negativeResponse(seq uint16, code ResponseCode) []byte {
+	return []byte{dataTypeResponse, 0x00, 0x01, byte(seq >> 8), byte(seq), byte(code), 0xff, 0xef}
+}
+
+// parseResponse decodes a RESPONSE message, header included and IAC EOR
+// excluded
+func parseResponse(data []byte, h TN3270NegoHandler) {
+	rh, ok := h.(TN3270ResponseHandler)
+	if !ok || len(data) < 6 {
+		return
+	}
+	seq := uint16(data[3])<<8 | uint16(data[4])
+	if data[2] == 0x01 { // ERROR-RESPONSE
+		rh.OnTN3270Response(seq, &ResponseError{Seq: seq, Code: ResponseCode(data[5])})
+	} else {
+		rh.OnTN3270Response(seq, nil)
+	}
+}
+
+// Function is a TN3270E function negotiated with FUNCTIONS subnegotiations
+type Function byte
+
+const (
+	FunctionBindImage     Function = 0x00
+	FunctionDataStreamCtl Function = 0x01
+	FunctionResponses     Function = 0x02
+	FunctionSCSCtlCodes   Function = 0x03
+	FunctionSysreq        Function = 0x04
+)
+
+func (f Function) String() string {
+	return byteName(tn3270Functions, byte(f))
+}
+
+// Functions is a set of TN3270E functions
+type Functions []Function
+
+// Has reports whether f is part of the set
+func (fs Functions) Has(f Function) bool {
+	for _, f1 := range fs {
+		if f1 == f {
+			return true
+		}
+	}
+	return false
+}
+
+// intersect returns the functions of fs that are also part of other
+func (fs Functions) intersect(other Functions) Functions {
+	common := Functions{}
+	for _, f := range fs {
+		if other.Has(f) && !common.Has(f) {
+			common = append(common, f)
+		}
+	}
+	return common
+}
+
+// subsetOf reports whether all the functions of fs are part of other
+func (fs Functions) subsetOf(other Functions) bool {
+	for _, f := range fs {
+		if !other.Has(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// answerFunctions answers a FUNCTIONS REQUEST: the requested functions are
+// agreed to with a FUNCTIONS IS if they are all supported, otherwise the
+// supported ones are requested in turn and agreed is nil
+func answerFunctions(requested, supported Functions) (agreed Functions, answer []byte) {
+	if !requested.subsetOf(supported) {
+		return nil, functionsRequest(requested.intersect(supported))
+	}
+	return requested, functionsIs(requested)
+}
+
+func parseFunctions(data []byte) Functions {
+	fs := make(Functions, len(data))
+	for i, b := range data {
+		fs[i] = Function(b)
+	}
+	return fs
+}
+
+// functionsRequest and functionsIs return FUNCTIONS subnegotiations
+func functionsRequest(fs Functions) []byte {
+	return functionsMessage(0x07, fs)
+}
+
+func functionsIs(fs Functions) []byte {
+	return functionsMessage(0x04, fs)
+}
+
+func functionsMessage(cmd byte, fs Functions) []byte {
+	msg := []byte{0xff, 0xfa, 0x28, 0x03, cmd}
+	for _, f := range fs {
+		msg = append(msg, byte(f))
+	}
+	return append(msg, 0xff, 0xf0)
+}
+
+// deviceTypes lists the device types a TN3270E server may accept
+var deviceTypes = map[string]bool{
+	"IBM-3278-2": true, "IBM-3278-2-E": true,
+	"IBM-3278-3": true, "IBM-3278-3-E": true,
+	"IBM-3278-4": true, "IBM-3278-4-E": true,
+	"IBM-3278-5": true, "IBM-3278-5-E": true,
+	"IBM-3279-2": true, "IBM-3279-2-E": true,
+	"IBM-3279-3": true, "IBM-3279-3-E": true,
+	"IBM-3279-4": true, "IBM-3279-4-E": true,
+	"IBM-3279-5": true, "IBM-3279-5-E": true,
+	"IBM-DYNAMIC": true,
+	"IBM-3287-1":  true,
+}
+
+// parseTN3270ESubneg decodes a TN3270E subnegotiation (without the leading
+// option byte) and calls the matching handler function. It returns false if
+// the subnegotiation is malformed.
+func parseTN3270ESubneg(data []byte, h TN3270NegoHandler) bool {
+	if len(data) < 2 {
+		return false
+	}
+	switch {
+	case data[0] == 0x08 && data[1] == 0x02: // SEND DEVICE-TYPE
+		h.OnTN3270SendDeviceType()
+	case data[0] == 0x02 && data[1] == 0x07: // DEVICE-TYPE REQUEST
+		deviceType, rest := splitDeviceType(data[2:])
+		switch {
+		case len(rest) == 0:
+			h.OnTN3270DeviceTypeRequest(deviceType, nil, nil)
+		case rest[0] == 0x01: // CONNECT
+			h.OnTN3270DeviceTypeRequest(deviceType, nil, rest[1:])
+		case rest[0] == 0x00: // ASSOCIATE
+			h.OnTN3270DeviceTypeRequest(deviceType, rest[1:], nil)
+		default:
+			return false
+		}
+	case data[0] == 0x02 && data[1] == 0x04: // DEVICE-TYPE IS
+		deviceType, rest := splitDeviceType(data[2:])
+		if len(rest) == 0 || rest[0] != 0x01 {
+			return false
+		}
+		h.OnTN3270DeviceTypeIs(deviceType, rest[1:])
+	case data[0] == 0x02 && data[1] == 0x06: // DEVICE-TYPE REJECT
+		if len(data) != 4 || data[2] != 0x05 {
+			return false
+		}
+		h.OnTN3270DeviceTypeReject(data[3])
+	case data[0] == 0x03 && data[1] == 0x07: // FUNCTIONS REQUEST
+		h.OnTN3270FunctionsRequest(data[2:])
+	case data[0] == 0x03 && data[1] == 0x04: // FUNCTIONS IS
+		h.OnTN3270FunctionsIs(data[2:])
+	default:
+		return false
+	}
+	return true
+}
+
+// splitDeviceType returns the device type at the beginning of data and what
+// follows it
+func splitDeviceType(data []byte) ([]byte, []byte) {
+	i := bytes.IndexAny(data, "\x00\x01")
+	if i == -1 {
+		return data, nil
+	}
+	return data[:i], data[i:]
+}
+
+// deviceTypeRequest requests a specific LU if connect is set, the printer
+// associated to the device name associate if set, a generic LU otherwise
+func deviceTypeRequest(deviceType string, associate string, connect string) []byte {
+	msg := []byte{0xff, 0xfa, 0x28, 0x02, 0x07}
+	msg = append(msg, deviceType...)
+	switch {
+	case connect != "":
+		msg = append(msg, 0x01)
+		msg = append(msg, connect...)
+	case associate != "":
+		msg = append(msg, 0x00)
+		msg = append(msg, associate...)
+	}
+	return append(msg, 0xff, 0xf0)
+}
+
+func deviceTypeIs(deviceType string, deviceName string) []byte {
+	msg := []byte{0xff, 0xfa, 0x28, 0x02, 0x04}
+	msg = append(msg, deviceType...)
+	msg = append(msg, 0x01)
+	msg = append(msg, deviceName...)
+	return append(msg, 0xff, 0xf0)
+}
+
+func deviceTypeReject(reason ReasonCode) []byte {
+	return []byte{0xff, 0xfa, 0x28, 0x02, 0x06, 0x05, byte(reason), 0xff, 0xf0}
+}
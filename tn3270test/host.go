@@ -0,0 +1,243 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tn3270test provides a scriptable fake host to test TN3270 client
+// automation without a mainframe, in the spirit of net/http/httptest.
+//
+// A Host plays a script: it displays the screen of the first step as the
+// welcome screen, then checks every input of the client against the next
+// step and answers with the screen of that step.
+package tn3270test
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+// Input describes the input expected from the client
+type Input struct {
+	AID    tn3270.AID     // expected key, any key if zero
+	Text   string         // expected text of the whole input, any text if empty
+	Fields map[int]string // expected field values by buffer address
+}
+
+// Step is one exchange of a script
+type Step struct {
+	Expect *Input // input expected before the step, anything if nil
+
+	Screen       string        // screen sent by the host
+	Delay        time.Duration // wait before answering
+	LockKeyboard bool          // leave the keyboard locked after the screen
+
+	// Write writes the screen instead of Screen if set, it may build a
+	// formatted screen through tn3270.ScreenWriter
+	Write func(w tn3270.ResponseWriter)
+
+	// Reject answers with a negative response instead of a screen, the
+	// client stays on the same step
+	Reject bool
+	Code   tn3270.ResponseCode
+}
+
+// MismatchError reports an input that does not match the script
+type MismatchError struct {
+	Step    int
+	Request *tn3270.Request
+	Reason  string
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("tn3270test: step %d: %s", e.Step, e.Reason)
+}
+
+// Host is a fake TN3270 host playing a script for every session
+type Host struct {
+	Addr     string // address of the loopback listener, host:port
+	Listener net.Listener
+	Server   *tn3270.Server
+
+	steps []Step
+
+	mu       sync.Mutex
+	requests []*tn3270.Request
+	errs     []error
+	done     int // sessions that reached the end of the script
+}
+
+// NewHost starts a host playing the given steps on a loopback address. The
+// first step is the welcome screen, its Expect is ignored.
+func NewHost(steps ...Step) *Host {
+	h := NewUnstartedHost(steps...)
+	h.Start()
+	return h
+}
+
+// NewUnstartedHost returns a host that is not listening yet, so that its
+// Server can be configured before calling Start
+func NewUnstartedHost(steps ...Step) *Host {
+	h := &Host{steps: steps}
+	h.Server = &tn3270.Server{Handler: h}
+	return h
+}
+
+// Start listens on a loopback address and serves clients
+func (h *Host) Start() {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("tn3270test: failed to listen: %v", err))
+	}
+	h.Listener = l
+	h.Addr = l.Addr().String()
+	go h.Server.Serve(l)
+}
+
+// Pipe returns the client end of an in-memory connection to the host
+func (h *Host) Pipe() net.Conn {
+	client, server := net.Pipe()
+	go h.Server.ServeConn(server)
+	return client
+}
+
+// Close stops the host and closes all its sessions
+func (h *Host) Close() {
+	h.Server.Close()
+}
+
+// Requests returns the inputs received by the host so far
+func (h *Host) Requests() []*tn3270.Request {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*tn3270.Request(nil), h.requests...)
+}
+
+// Err returns the first input that did not match the script, nil if all
+// inputs matched
+func (h *Host) Err() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.errs) == 0 {
+		return nil
+	}
+	return h.errs[0]
+}
+
+// Done tells whether a session went through the whole script
+func (h *Host) Done() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.done > 0
+}
+
+const stepKey = "tn3270test.step"
+
+func (h *Host) ServeWelcomeScreen(w tn3270.ResponseWriter) {
+	if len(h.steps) == 0 {
+		return
+	}
+	h.reply(w, &h.steps[0])
+}
+
+func (h *Host) ServeTN3270(w tn3270.ResponseWriter, r *tn3270.Request) {
+	h.mu.Lock()
+	h.requests = append(h.requests, r)
+	h.mu.Unlock()
+
+	n, _ := r.Session.Get(stepKey).(int)
+	n++
+	if n >= len(h.steps) {
+		h.fail(&MismatchError{Step: n, Request: r, Reason: "unexpected input after the end of the script"})
+		reject(w, tn3270.ResponseCommandReject)
+		return
+	}
+	step := &h.steps[n]
+	if err := step.check(n, r); err != nil {
+		h.fail(err)
+		reject(w, tn3270.ResponseCommandReject)
+		return
+	}
+	if !step.Reject {
+		r.Session.Set(stepKey, n)
+		if n == len(h.steps)-1 {
+			h.mu.Lock()
+			h.done++
+			h.mu.Unlock()
+		}
+	}
+	h.reply(w, step)
+}
+
+// reject answers with a negative response, middlewares may hide the
+// ResponseController of the server, the terminal then gets an empty screen
+func reject(w tn3270.ResponseWriter, code tn3270.ResponseCode) {
+	if rc, ok := w.(tn3270.ResponseController); ok {
+		rc.Reject(code)
+	}
+}
+
+func (h *Host) fail(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.errs = append(h.errs, err)
+}
+
+func (h *Host) reply(w tn3270.ResponseWriter, step *Step) {
+	time.Sleep(step.Delay)
+	if rc, ok := w.(tn3270.ResponseController); ok {
+		if step.Reject {
+			rc.Reject(step.Code)
+			return
+		}
+		if step.LockKeyboard {
+			rc.LockKeyboard()
+		}
+	}
+	if step.Write != nil {
+		step.Write(w)
+		return
+	}
+	io.WriteString(w, step.Screen)
+}
+
+func (s *Step) check(n int, r *tn3270.Request) error {
+	in := s.Expect
+	if in == nil {
+		return nil
+	}
+	if in.AID != 0 && in.AID != r.AID {
+		return &MismatchError{Step: n, Request: r, Reason: fmt.Sprintf("expected AID %s, got %s", in.AID, r.AID)}
+	}
+	if in.Text != "" && in.Text != r.Text {
+		return &MismatchError{Step: n, Request: r, Reason: fmt.Sprintf("expected text %q, got %q", in.Text, r.Text)}
+	}
+	for addr, value := range in.Fields {
+		found := false
+		for _, f := range r.Fields {
+			if f.Addr == addr {
+				found = true
+				if f.Value != value {
+					return &MismatchError{Step: n, Request: r, Reason: fmt.Sprintf("expected %q at %d, got %q", value, addr, f.Value)}
+				}
+			}
+		}
+		if !found {
+			return &MismatchError{Step: n, Request: r, Reason: fmt.Sprintf("expected %q at %d, got no field", value, addr)}
+		}
+	}
+	return nil
+}
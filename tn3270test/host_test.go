@@ -0,0 +1,123 @@
+package tn3270test_test
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+	"github.com/wuzuf/go-tn3270/tn3270test"
+)
+
+type traceLog struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *traceLog) Printf(format string, v ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func (l *traceLog) Lines() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.lines...)
+}
+
+var logon = []tn3270test.Step{
+	{Screen: "ENTER USER ID"},
+	{
+		Expect: &tn3270test.Input{AID: tn3270.AIDEnter, Fields: map[int]string{80: "JDOE"}},
+		Screen: "MAIN MENU",
+	},
+	{
+		Expect: &tn3270test.Input{Text: "LOGOFF"},
+		Screen: "GOODBYE",
+	},
+}
+
+var _ = Describe("Host", func() {
+	var host *tn3270test.Host
+
+	AfterEach(func() {
+		host.Close()
+	})
+
+	It("Should play a script over loopback", func() {
+		host = tn3270test.NewHost(logon...)
+		client := tn3270.NewClient("")
+		recv, err := client.Connect(host.Addr)
+		Expect(err).To(Succeed())
+		Expect(<-recv).To(Equal("ENTER USER ID"))
+		Expect(client.SendRecv("JDOE")).To(Equal("MAIN MENU"))
+		Expect(client.SendRecv("LOGOFF")).To(Equal("GOODBYE"))
+		Expect(host.Err()).To(Succeed())
+		Expect(host.Done()).To(BeTrue())
+		Expect(host.Requests()).To(HaveLen(2))
+		Expect(host.Requests()[0].Fields).To(Equal([]tn3270.Field{{Addr: 80, Value: "JDOE"}}))
+	})
+
+	It("Should play a script over a pipe", func() {
+		host = tn3270test.NewHost(logon...)
+		client := tn3270.NewClient("")
+		recv, err := client.ConnectConn(host.Pipe())
+		Expect(err).To(Succeed())
+		Expect(<-recv).To(Equal("ENTER USER ID"))
+		Expect(client.SendRecv("JDOE")).To(Equal("MAIN MENU"))
+	})
+
+	It("Should reject unexpected inputs", func() {
+		host = tn3270test.NewHost(logon...)
+		client := tn3270.NewClient("")
+		recv, err := client.ConnectConn(host.Pipe())
+		Expect(err).To(Succeed())
+		<-recv
+		Expect(client.SendRecv("ROOT")).To(Equal("ENTER USER ID"))
+		Expect(client.ResponseError()).To(Equal(&tn3270.ResponseError{Code: tn3270.ResponseCommandReject}))
+		err = host.Err()
+		Expect(err).To(BeAssignableToTypeOf(&tn3270test.MismatchError{}))
+		Expect(err.Error()).To(ContainSubstring(`expected "JDOE" at 80, got "ROOT"`))
+		Expect(client.SendRecv("JDOE")).To(Equal("MAIN MENU"))
+		Expect(client.ResponseError()).To(Succeed())
+	})
+
+	It("Should give the keyboard back to terminals without RESPONSES", func() {
+		host = tn3270test.NewHost(logon...)
+		client := tn3270.NewClient("")
+		client.Functions = tn3270.Functions{}
+		recv, err := client.ConnectConn(host.Pipe())
+		Expect(err).To(Succeed())
+		<-recv
+		client.SendRecv("ROOT")
+		Expect(client.ResponseError()).To(Succeed())
+		Expect(client.Screen().String()).To(HavePrefix("ENTER USER ID"))
+		Expect(client.Screen().KeyboardLocked()).To(BeFalse())
+		Expect(host.Err()).To(HaveOccurred())
+	})
+
+	It("Should simulate delays, keyboard lock and negative responses", func() {
+		host = tn3270test.NewHost(
+			tn3270test.Step{Screen: "READY"},
+			tn3270test.Step{Screen: "BUSY", Delay: 50 * time.Millisecond, LockKeyboard: true},
+			tn3270test.Step{Reject: true, Code: tn3270.ResponseInterventionRequired},
+		)
+		trace := &traceLog{}
+		client := tn3270.NewClient("")
+		client.Trace = trace
+		recv, err := client.ConnectConn(host.Pipe())
+		Expect(err).To(Succeed())
+		Expect(<-recv).To(Equal("READY"))
+		start := time.Now()
+		Expect(client.SendRecv("GO")).To(Equal("BUSY"))
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+		Expect(trace.Lines()).To(ContainElement("< WCC 0xc1"))
+		Expect(client.SendRecv("GO")).To(Equal("BUSY"))
+		Expect(client.ResponseError()).To(Equal(&tn3270.ResponseError{Code: tn3270.ResponseInterventionRequired}))
+		Expect(host.Err()).To(Succeed())
+	})
+})
@@ -0,0 +1,54 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270test
+
+import (
+	"io"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+// LogonScreen writes a formatted logon screen for Step.Write. Its first row
+// has five fields:
+//
+//	0   protected "USER" label
+//	10  user input field holding the cursor, its data starts at 11
+//	20  protected "PASSWORD" label
+//	30  hidden password field holding "SECRET", its data starts at 31
+//	40  protected
+//
+// Only "LOGON" is written if the ResponseWriter cannot build formatted
+// screens.
+func LogonScreen(w tn3270.ResponseWriter) {
+	sw, ok := w.(tn3270.ScreenWriter)
+	if !ok {
+		io.WriteString(w, "LOGON")
+		return
+	}
+	sw.SetBufferAddress(0, 0)
+	sw.StartField(tn3270.FieldProtected)
+	io.WriteString(w, "USER")
+	sw.SetBufferAddress(0, 10)
+	sw.StartField(0)
+	sw.InsertCursor()
+	sw.SetBufferAddress(0, 20)
+	sw.StartField(tn3270.FieldProtected)
+	io.WriteString(w, "PASSWORD")
+	sw.SetBufferAddress(0, 30)
+	sw.StartField(tn3270.FieldHidden)
+	io.WriteString(w, "SECRET")
+	sw.SetBufferAddress(0, 40)
+	sw.StartField(tn3270.FieldProtected)
+}
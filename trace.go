@@ -0,0 +1,284 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+)
+
+// TraceWriter returns a Logger writing timestamped trace lines to w, to be
+// used as the Trace of a Client or a Server
+func TraceWriter(w io.Writer) Logger {
+	return log.New(w, "", log.Ltime|log.Lmicroseconds)
+}
+
+var telnetCommands = map[byte]string{
+	0xf0: "SE", 0xf1: "NOP", 0xf2: "DM", 0xf3: "BRK", 0xf4: "IP", 0xf5: "AO",
+	0xf6: "AYT", 0xf7: "EC", 0xf8: "EL", 0xf9: "GA", 0xfa: "SB", 0xfb: "WILL",
+	0xfc: "WONT", 0xfd: "DO", 0xfe: "DONT", 0xef: "EOR",
+}
+
+var telnetOptions = map[byte]string{
+	0x00: "BINARY", 0x01: "ECHO", 0x03: "SUPPRESS-GO-AHEAD", 0x06: "TIMING-MARK",
+	0x18: "TERMINAL-TYPE", 0x19: "END-OF-RECORD", 0x27: "NEW-ENVIRON",
+	0x28: "TN3270E", 0x2e: "START_TLS",
+}
+
+var tn3270Commands = map[byte]string{
+	0x01: "Write", 0xf1: "Write", 0x05: "EraseWrite", 0xf5: "EraseWrite",
+	0x0d: "EraseWriteAlternate", 0x7e: "EraseWriteAlternate",
+	0x0f: "EraseAllUnprotected", 0x6f: "EraseAllUnprotected",
+	0x02: "ReadBuffer", 0xf2: "ReadBuffer", 0x06: "ReadModified", 0xf6: "ReadModified",
+	0x6e: "ReadModifiedAll", 0x11: "WriteStructuredField", 0xf3: "WriteStructuredField",
+}
+
+var tn3270Functions = map[byte]string{
+	0x00: "BIND-IMAGE", 0x01: "DATA-STREAM-CTL", 0x02: "RESPONSES",
+	0x03: "SCS-CTL-CODES", 0x04: "SYSREQ",
+}
+
+func byteName(names map[byte]string, b byte) string {
+	if name, ok := names[b]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%02x", b)
+}
+
+// tracer logs decoded protocol events, it implements all the parser
+// handler interfaces
+type tracer struct {
+	logger Logger
+	prefix string
+	cols   int
+	cp     *CodePage
+}
+
+func (t *tracer) printf(format string, v ...interface{}) {
+	t.logger.Printf(t.prefix+format, v...)
+}
+
+func (t *tracer) OnTNCommand(b byte) {
+	t.printf("IAC %s", byteName(telnetCommands, b))
+}
+
+func (t *tracer) OnTNArgCommand(b byte, arg byte) {
+	t.printf("IAC %s %s", byteName(telnetCommands, b), byteName(telnetOptions, arg))
+}
+
+var subnegCommands = map[byte]string{0x00: "IS", 0x01: "SEND", 0x02: "INFO"}
+
+// OnTNSubnegotiation decodes the TERMINAL-TYPE, NEW-ENVIRON and START_TLS
+// subnegotiations
+func (t *tracer) OnTNSubnegotiation(option byte, data []byte) bool {
+	if option == OptionStartTLS && isStartTLSFollows(data) {
+		t.printf("SB START_TLS FOLLOWS")
+		return true
+	}
+	if len(data) == 0 || (option != OptionTerminalType && option != OptionNewEnviron) {
+		return false
+	}
+	line := fmt.Sprintf("SB %s %s", telnetOptions[option], byteName(subnegCommands, data[0]))
+	switch {
+	case option == OptionTerminalType && len(data) > 1:
+		line += " " + string(data[1:])
+	case option == OptionNewEnviron:
+		for _, v := range parseEnviron(data[1:]) {
+			if v.user {
+				line += " USERVAR " + v.name
+			} else {
+				line += " VAR " + v.name
+			}
+			if v.hasValue {
+				line += " VALUE " + v.value
+			}
+		}
+	}
+	t.printf("%s", line)
+	return true
+}
+
+func (t *tracer) OnTN3270DeviceTypeRequest(deviceType []byte, deviceName []byte, resourceName []byte) {
+	t.setModel(string(deviceType))
+	switch {
+	case len(resourceName) > 0:
+		t.printf("SB TN3270E DEVICE-TYPE REQUEST %s CONNECT %s", deviceType, resourceName)
+	case len(deviceName) > 0:
+		t.printf("SB TN3270E DEVICE-TYPE REQUEST %s ASSOCIATE %s", deviceType, deviceName)
+	default:
+		t.printf("SB TN3270E DEVICE-TYPE REQUEST %s", deviceType)
+	}
+}
+
+func (t *tracer) OnTN3270DeviceTypeIs(deviceType []byte, deviceName []byte) {
+	t.setModel(string(deviceType))
+	t.printf("SB TN3270E DEVICE-TYPE IS %s CONNECT %s", deviceType, deviceName)
+}
+
+func (t *tracer) OnTN3270DeviceTypeReject(reason byte) {
+	t.printf("SB TN3270E DEVICE-TYPE REJECT REASON %s", ReasonCode(reason))
+}
+
+func (t *tracer) OnTN3270FunctionsIs(functions []byte) {
+	t.printf("SB TN3270E FUNCTIONS IS%s", t.functions(functions))
+}
+
+func (t *tracer) OnTN3270FunctionsRequest(functions []byte) {
+	t.printf("SB TN3270E FUNCTIONS REQUEST%s", t.functions(functions))
+}
+
+func (t *tracer) OnTN3270SendDeviceType() {
+	t.printf("SB TN3270E SEND DEVICE-TYPE")
+}
+
+func (t *tracer) OnTN3270Response(seq uint16, err *ResponseError) {
+	if err != nil {
+		t.printf("RESPONSE %d NEGATIVE %s", seq, err.Code)
+	} else {
+		t.printf("RESPONSE %d POSITIVE", seq)
+	}
+}
+
+func (t *tracer) functions(functions []byte) string {
+	var s string
+	for _, f := range functions {
+		s += " " + byteName(tn3270Functions, f)
+	}
+	return s
+}
+
+// setModel sets the screen width used to display addresses
+func (t *tracer) setModel(deviceType string) {
+	if strings.HasPrefix(deviceType, "IBM-3278-5") || strings.HasPrefix(deviceType, "IBM-3279-5") {
+		t.cols = 132
+	} else {
+		t.cols = 80
+	}
+}
+
+func (t *tracer) addr(addr int) string {
+	return fmt.Sprintf("%d,%d", addr/t.cols, addr%t.cols)
+}
+
+func (t *tracer) OnTN3270Command(b byte) {
+	t.printf("%s", byteName(tn3270Commands, b))
+}
+
+func (t *tracer) OnTN3270Text(text []byte) {
+	t.printf("TEXT %q", t.cp.Decode(text))
+}
+
+func (t *tracer) OnTN3270WCC(b byte) {
+	t.printf("WCC 0x%02x", b)
+}
+
+func (t *tracer) OnTN3270AID(b byte) {
+	t.printf("AID %s", AID(b))
+}
+
+func (t *tracer) OnTN3270PT() {
+	t.printf("PT")
+}
+
+func (t *tracer) OnTN3270IC() {
+	t.printf("IC")
+}
+
+func (t *tracer) OnTN3270SF(b byte) {
+	t.printf("SF 0x%02x", b)
+}
+
+func (t *tracer) OnTN3270SFE(b byte) {
+	t.printf("SFE %d", b)
+}
+
+func (t *tracer) OnTN3270FieldAttribute(typ byte, v byte) {
+	t.printf("  ATTR 0x%02x=0x%02x", typ, v)
+}
+
+func (t *tracer) OnTN3270SA(typ byte, v byte) {
+	t.printf("SA 0x%02x=0x%02x", typ, v)
+}
+
+func (t *tracer) OnTN3270RA(addr int, b byte) {
+	t.printf("RA %s 0x%02x", t.addr(addr), b)
+}
+
+func (t *tracer) OnTN3270SBA(addr int) {
+	t.printf("SBA %s", t.addr(addr))
+}
+
+func (t *tracer) OnTN3270EUA(addr int) {
+	t.printf("EUA %s", t.addr(addr))
+}
+
+func (t *tracer) OnTN3270Message() {
+	t.printf("EOR")
+}
+
+func (t *tracer) OnError(data []byte, position int) error {
+	t.printf("ERROR at %d: %x", position, data)
+	return nil
+}
+
+// traceConn decodes and logs what goes through a connection
+type traceConn struct {
+	net.Conn
+	in  Parser
+	out Parser
+}
+
+// newTraceConn wraps conn to trace it. The host side of the connection is a
+// server if host is false.
+func newTraceConn(conn net.Conn, logger Logger, prefix string, cp *CodePage, host bool) net.Conn {
+	recv := &tracer{logger: logger, prefix: prefix + "< ", cols: 80, cp: codePageOrDefault(cp)}
+	sent := &tracer{logger: logger, prefix: prefix + "> ", cols: 80, cp: codePageOrDefault(cp)}
+	c := &traceConn{Conn: conn}
+	if host {
+		c.in = newInboundParser(recv, recv, recv, recv)
+		c.out = NewParser(sent, sent, sent, sent)
+	} else {
+		c.in = NewParser(recv, recv, recv, recv)
+		c.out = newInboundParser(sent, sent, sent, sent)
+	}
+	return c
+}
+
+// resync drops the incomplete data received, such as the beginning of a
+// TLS handshake read past START_TLS
+func (c *traceConn) resync() {
+	if p, ok := c.in.(*telnetParser); ok {
+		p.pending = p.pending[:0]
+	}
+}
+
+func (c *traceConn) Read(p []byte) (n int, err error) {
+	n, err = c.Conn.Read(p)
+	if n > 0 {
+		c.in.Parse(p[:n])
+	}
+	return
+}
+
+func (c *traceConn) Write(p []byte) (n int, err error) {
+	n, err = c.Conn.Write(p)
+	if n > 0 {
+		c.out.Parse(p[:n])
+	}
+	return
+}
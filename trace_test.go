@@ -0,0 +1,118 @@
+package tn3270_test
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+// traceLog collects trace lines
+type traceLog struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *traceLog) Printf(format string, v ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func (l *traceLog) Lines() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.lines...)
+}
+
+var _ = Describe("Trace", func() {
+	var listener net.Listener
+	var server *tn3270.Server
+
+	BeforeEach(func() {
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).To(Succeed())
+		server = &tn3270.Server{Handler: &MyHandler{}}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Should log decoded client events", func() {
+		go server.Serve(listener)
+		trace := &traceLog{}
+		client := tn3270.NewClient("09123456")
+		client.Trace = trace
+		recv, err := client.Connect(listener.Addr().String())
+		Expect(err).To(Succeed())
+		<-recv
+		Expect(client.SendRecv("Hello")).To(Equal("ECHO: Hello"))
+
+		Eventually(trace.Lines).Should(ContainElement("< IAC DO TN3270E"))
+		lines := trace.Lines()
+		Expect(lines).To(ContainElement("> IAC WILL TN3270E"))
+		Expect(lines).To(ContainElement("< SB TN3270E SEND DEVICE-TYPE"))
+		Expect(lines).To(ContainElement("> SB TN3270E DEVICE-TYPE REQUEST IBM-3278-2-E CONNECT 09123456"))
+		Expect(lines).To(ContainElement("< EraseWrite"))
+		Expect(lines).To(ContainElement("< SBA 1,0"))
+		Expect(lines).To(ContainElement(`< TEXT "WELCOME TO MY TN3270 SERVER"`))
+		Expect(lines).To(ContainElement("> AID Enter"))
+		Expect(lines).To(ContainElement(`> TEXT "Hello"`))
+		Expect(lines).To(ContainElement(`< TEXT "ECHO: Hello"`))
+	})
+
+	It("Should log the environment variables", func() {
+		server.Environ = []string{"USER"}
+		go server.Serve(listener)
+		trace := &traceLog{}
+		client := tn3270.NewClient("09123456")
+		client.Trace = trace
+		client.Environ = map[string]string{"USER": "ALICE"}
+		recv, err := client.Connect(listener.Addr().String())
+		Expect(err).To(Succeed())
+		<-recv
+
+		lines := trace.Lines()
+		Expect(lines).To(ContainElement("< SB NEW-ENVIRON SEND VAR USER"))
+		Expect(lines).To(ContainElement("> SB NEW-ENVIRON IS VAR USER VALUE ALICE"))
+	})
+
+	It("Should log server events to a writer", func() {
+		var buf bytes.Buffer
+		var mu sync.Mutex
+		server.Trace = tn3270.TraceWriter(&lockedWriter{w: &buf, mu: &mu})
+		go server.Serve(listener)
+		client := tn3270.NewClient("09123456")
+		recv, err := client.Connect(listener.Addr().String())
+		Expect(err).To(Succeed())
+		<-recv
+		Expect(client.SendRecv("Hello")).To(Equal("ECHO: Hello"))
+		Eventually(func() string {
+			mu.Lock()
+			defer mu.Unlock()
+			return buf.String()
+		}).Should(ContainSubstring(`> TEXT "ECHO: Hello"`))
+		mu.Lock()
+		defer mu.Unlock()
+		Expect(buf.String()).To(ContainSubstring(`< TEXT "Hello"`))
+		Expect(buf.String()).To(ContainSubstring("> IAC DO TN3270E"))
+	})
+})
+
+type lockedWriter struct {
+	w  *bytes.Buffer
+	mu *sync.Mutex
+}
+
+func (w *lockedWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.w.Write(p)
+}
@@ -0,0 +1,153 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tn3270
+
+import (
+	"regexp"
+	"strings"
+)
+
+// sscpData encodes text for a SSCP-LU-DATA record, lines are separated by
+// new lines
+func sscpData(cp *CodePage, text string) []byte {
+	var data []byte
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			data = append(data, scsNL)
+		}
+		data = append(data, cp.Encode(line)...)
+	}
+	return data
+}
+
+// writeSSCP writes SSCP-LU data, which is unformatted, from the start of
+// the line after the previous write. The screen is cleared when full.
+func (h *VirtualScreenTN3270Handler) writeSSCP(data []byte) {
+	if h.position%h.cols != 0 {
+		h.position += h.cols - h.position%h.cols
+	}
+	for i := 0; i < len(data); i++ {
+		if h.position >= h.size() {
+			h.clear()
+		}
+		switch b := data[i]; b {
+		case scsNL:
+			h.position += h.cols - h.position%h.cols
+		case 0x11: // SBA
+			if i+2 < len(data) {
+				h.position = decodeAddr(data[i+1], data[i+2]) % h.size()
+			}
+			i += 2
+		case 0x1d: // SF, the attribute shows as a blank
+			h.screen[h.position] = 0x40
+			h.position++
+			i++
+		case 0x13: // IC
+			h.cursor = h.position
+		default:
+			h.screen[h.position] = b
+			h.position++
+		}
+	}
+	h.cursor = h.position % h.size()
+	h.locked = false
+}
+
+// USS messages of the SSCP-LU session
+const (
+	ussInvalidCommand = "INVALID COMMAND SYNTAX"
+	ussUnknownAppl    = "UNKNOWN APPLICATION "
+)
+
+var ussLogon = regexp.MustCompile(`(?i)^LOGON\s+APPLID\s*\(\s*([A-Z0-9$#@]+)\s*\)`)
+
+// parseUSSCommand decodes the LOGON APPLID(name) and LOGOFF commands typed
+// in the SSCP-LU session
+func parseUSSCommand(command string) (verb string, application string) {
+	command = strings.TrimSpace(command)
+	if m := ussLogon.FindStringSubmatch(command); m != nil {
+		return "LOGON", strings.ToUpper(m[1])
+	}
+	if strings.EqualFold(command, "LOGOFF") {
+		return "LOGOFF", ""
+	}
+	return "", ""
+}
+
+// ussEnabled reports whether the connection starts in the SSCP-LU session
+func (c *conn) ussEnabled() bool {
+	return c.server.USSMessage != "" && !c.isPrinter()
+}
+
+// writeSSCP sends text to the SSCP-LU session of the terminal
+func (c *conn) writeSSCP(text string) {
+	c.write([]byte{dataTypeSSCPLU, 0x00, 0x00, 0x00, 0x00},
+		escapeIAC(sscpData(codePageOrDefault(c.server.CodePage), text)),
+		[]byte{0xff, 0xef})
+}
+
+// knownApplication reports whether terminals may log on to the application
+func (s *Server) knownApplication(name string) bool {
+	if s.Applications == nil {
+		return true
+	}
+	for _, a := range s.Applications {
+		if strings.EqualFold(a, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// OnTN3270SSCPLUData runs the commands typed in the SSCP-LU session, LOGON
+// switches to the LU-LU session with the application and LOGOFF leaves it.
+// The data sent while the LU-LU session is active is ignored.
+func (h *defaultTNHandler) OnTN3270SSCPLUData(data []byte) {
+	c := h.c
+	if !c.ussEnabled() || !c.sscp || !c.ready() {
+		return
+	}
+	verb, application := parseUSSCommand(h.cp.Decode(data))
+	switch {
+	case verb == "LOGON" && !c.server.knownApplication(application):
+		c.writeSSCP(ussUnknownAppl + application)
+	case verb == "LOGON":
+		c.session.Application = application
+		c.sscp = false
+		h.serveWelcomeScreen()
+	case verb == "LOGOFF":
+		c.session.Application = ""
+		c.writeSSCP(c.server.USSMessage)
+	default:
+		c.writeSSCP(ussInvalidCommand)
+	}
+}
+
+// sysReq switches between the SSCP-LU and LU-LU sessions when the terminal
+// presses SYSREQ. Back to the LU-LU session the welcome screen is served
+// again.
+func (h *defaultTNHandler) sysReq() {
+	c := h.c
+	if !c.ussEnabled() || !c.functions.Has(FunctionSysreq) {
+		return
+	}
+	if c.sscp && c.session.Application != "" {
+		c.sscp = false
+		h.serveWelcomeScreen()
+		return
+	}
+	c.sscp = true
+	c.writeSSCP(c.server.USSMessage)
+}
@@ -0,0 +1,307 @@
+// Copyright 2016 Gabriel de Labachelerie
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wsbridge serves TN3270 sessions to browsers over WebSocket.
+//
+// Each WebSocket connection gets its own session to the host. The bridge
+// sends JSON messages describing the presentation space:
+//
+//	{"type": "screen", "rows": 24, "cols": 80, "cursor": {"row": 0, "col": 11},
+//	 "keyboardLocked": false, "changes": [{"row": 0, "col": 0, "text": "..."}],
+//	 "fields": [{"row": 0, "col": 11, "length": 9, "protected": false, ...}]}
+//
+// The first message holds every row, the next ones only the runs of
+// characters that changed, and the fields only when they changed. A
+// {"type": "disconnected"} message is sent when the host closes the session.
+//
+// The browser sends its input as JSON messages:
+//
+//	{"type": "key", "key": "Enter"}       Enter, Clear, PA1-PA3, PF1-PF24
+//	{"type": "text", "text": "JDOE"}      types at the cursor
+//	{"type": "move", "row": 0, "col": 11} moves the cursor
+//	{"type": "tab"}, {"type": "eraseEOF"}
+//
+// Input that cannot be applied is answered with {"type": "error", "error": "..."}.
+package wsbridge
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/wuzuf/go-tn3270"
+)
+
+// Handler bridges WebSocket connections to a TN3270 host
+type Handler struct {
+	Host     string           // host:port of the TN3270 host
+	CodePage *tn3270.CodePage // CP037 if nil
+
+	// Dial opens the connection to the host instead of dialing Host if set
+	Dial func() (net.Conn, error)
+
+	// Upgrader upgrades the HTTP requests, its CheckOrigin should be set
+	// to accept the pages the emulator is served from
+	Upgrader websocket.Upgrader
+}
+
+type position struct {
+	Row int `json:"row"`
+	Col int `json:"col"`
+}
+
+type change struct {
+	position
+	Text string `json:"text"`
+}
+
+type field struct {
+	position
+	Length    int  `json:"length"`
+	Protected bool `json:"protected"`
+	Hidden    bool `json:"hidden"`
+	Numeric   bool `json:"numeric"`
+}
+
+type outMessage struct {
+	Type           string    `json:"type"`
+	Rows           int       `json:"rows,omitempty"`
+	Cols           int       `json:"cols,omitempty"`
+	Cursor         *position `json:"cursor,omitempty"`
+	KeyboardLocked bool      `json:"keyboardLocked"`
+	Changes        []change  `json:"changes,omitempty"`
+	Fields         *[]field  `json:"fields,omitempty"` // nil if unchanged
+	Error          string    `json:"error,omitempty"`
+}
+
+type inMessage struct {
+	Type string `json:"type"`
+	Key  string `json:"key"`
+	Text string `json:"text"`
+	Row  int    `json:"row"`
+	Col  int    `json:"col"`
+}
+
+// bridge is a WebSocket connection and its host session
+type bridge struct {
+	ws     *websocket.Conn
+	client *tn3270.Client
+
+	mu     sync.Mutex // serializes writes to ws and protects the state below
+	cells  []string   // presentation space last sent
+	fields []field    // fields last sent
+	sent   bool
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ws, err := h.Upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// The upgrader already replied
+		return
+	}
+	defer ws.Close()
+
+	client := tn3270.NewClient(r.URL.Query().Get("lu"))
+	client.CodePage = h.CodePage
+	var recv chan string
+	if h.Dial != nil {
+		var conn net.Conn
+		if conn, err = h.Dial(); err == nil {
+			recv, err = client.ConnectConn(conn)
+		}
+	} else {
+		recv, err = client.Connect(h.Host)
+	}
+	if err != nil {
+		ws.WriteJSON(&outMessage{Type: "error", Error: err.Error()})
+		return
+	}
+	defer client.Close()
+
+	b := &bridge{ws: ws, client: client}
+	go b.forward(recv)
+	b.input()
+}
+
+// forward sends the screens of the host until it disconnects
+func (b *bridge) forward(recv chan string) {
+	for {
+		select {
+		case <-recv:
+			if b.update() != nil {
+				b.ws.Close()
+				return
+			}
+		case <-b.client.Done():
+			b.mu.Lock()
+			b.ws.WriteJSON(&outMessage{Type: "disconnected"})
+			b.mu.Unlock()
+			b.ws.Close()
+			return
+		}
+	}
+}
+
+// input applies the messages of the browser until the WebSocket is closed
+func (b *bridge) input() {
+	for {
+		var msg inMessage
+		if err := b.ws.ReadJSON(&msg); err != nil {
+			switch err.(type) {
+			case *json.SyntaxError, *json.UnmarshalTypeError:
+				// The message was read, the next one may be valid
+				b.fail(err)
+				continue
+			}
+			return
+		}
+		if err := b.apply(&msg); err != nil {
+			b.fail(err)
+			continue
+		}
+		if msg.Type != "key" {
+			// Keys are answered by the host
+			b.update()
+		}
+	}
+}
+
+func (b *bridge) apply(msg *inMessage) error {
+	switch msg.Type {
+	case "key":
+		aid, ok := tn3270.ParseAID(msg.Key)
+		if !ok {
+			return errors.New("unknown key " + msg.Key)
+		}
+		if b.client.Screen().KeyboardLocked() {
+			return tn3270.ErrKeyboardLocked
+		}
+		b.client.SendAID(aid)
+		return nil
+	case "text":
+		return b.client.Type(msg.Text)
+	case "move":
+		b.client.MoveCursor(msg.Row, msg.Col)
+		return nil
+	case "tab":
+		b.client.Tab()
+		return nil
+	case "eraseEOF":
+		return b.client.EraseEOF()
+	}
+	return errors.New("unknown message type " + msg.Type)
+}
+
+func (b *bridge) fail(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.ws.WriteJSON(&outMessage{Type: "error", Error: err.Error()})
+}
+
+// update sends the changes of the presentation space since the last update
+func (b *bridge) update() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	screen := b.client.Screen()
+	cols := screen.Cols()
+	cells, fields := presentationSpace(screen)
+	msg := &outMessage{
+		Type:           "screen",
+		Rows:           screen.Rows(),
+		Cols:           cols,
+		Cursor:         &position{screen.Cursor() / cols, screen.Cursor() % cols},
+		KeyboardLocked: screen.KeyboardLocked(),
+	}
+	// Runs of changed characters, row by row
+	for row := 0; row < screen.Rows(); row++ {
+		run := -1
+		for col := 0; col <= cols; col++ {
+			i := row*cols + col
+			changed := col < cols && (!b.sent || len(b.cells) != len(cells) || b.cells[i] != cells[i])
+			switch {
+			case changed && run == -1:
+				run = col
+			case !changed && run != -1:
+				text := ""
+				for _, c := range cells[row*cols+run : i] {
+					text += c
+				}
+				msg.Changes = append(msg.Changes, change{position{row, run}, text})
+				run = -1
+			}
+		}
+	}
+	if !b.sent || !equalFields(b.fields, fields) {
+		if fields == nil {
+			fields = []field{}
+		}
+		msg.Fields = &fields
+	}
+	b.cells, b.fields, b.sent = cells, fields, true
+	return b.ws.WriteJSON(msg)
+}
+
+// presentationSpace returns the text of each position, with hidden fields
+// blanked, and the fields of a screen
+func presentationSpace(screen *tn3270.VirtualScreenTN3270Handler) ([]string, []field) {
+	cols := screen.Cols()
+	cells := screen.Cells()
+	text := make([]string, len(cells))
+	hidden := false
+	// The first positions belong to the last field of the buffer
+	for i := len(cells) - 1; i >= 0; i-- {
+		if cells[i].FieldStart {
+			hidden = cells[i].Attr&tn3270.FieldHidden == tn3270.FieldHidden
+			break
+		}
+	}
+	for i, c := range cells {
+		switch {
+		case c.FieldStart:
+			hidden = c.Attr&tn3270.FieldHidden == tn3270.FieldHidden
+			text[i] = " "
+		case hidden:
+			text[i] = " "
+		case c.Char != 0:
+			text[i] = string(c.Char)
+		}
+	}
+	var fields []field
+	for _, f := range screen.Fields() {
+		fields = append(fields, field{
+			position:  position{f.Addr / cols, f.Addr % cols},
+			Length:    len([]rune(f.Value)),
+			Protected: f.Protected(),
+			Hidden:    f.Hidden(),
+			Numeric:   f.Numeric(),
+		})
+	}
+	return text, fields
+}
+
+func equalFields(a, b []field) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
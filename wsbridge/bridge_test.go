@@ -0,0 +1,120 @@
+package wsbridge_test
+
+import (
+	"net"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/wuzuf/go-tn3270"
+	"github.com/wuzuf/go-tn3270/tn3270test"
+	"github.com/wuzuf/go-tn3270/wsbridge"
+)
+
+type position struct {
+	Row, Col int
+}
+
+type message struct {
+	Type           string
+	Rows, Cols     int
+	Cursor         *position
+	KeyboardLocked bool
+	Changes        []struct {
+		Row, Col int
+		Text     string
+	}
+	Fields []struct {
+		Row, Col, Length int
+		Protected        bool
+		Hidden           bool
+	}
+	Error string
+}
+
+var _ = Describe("Bridge", func() {
+	var host *tn3270test.Host
+	var server *httptest.Server
+	var ws *websocket.Conn
+
+	BeforeEach(func() {
+		host = tn3270test.NewHost(
+			tn3270test.Step{Write: tn3270test.LogonScreen},
+			tn3270test.Step{
+				Expect: &tn3270test.Input{AID: tn3270.AIDEnter, Fields: map[int]string{11: "JDOE"}},
+				Screen: "READY",
+			},
+		)
+		server = httptest.NewServer(&wsbridge.Handler{
+			Dial: func() (net.Conn, error) { return host.Pipe(), nil },
+		})
+		var err error
+		ws, _, err = websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/?lu=LU01", nil)
+		Expect(err).To(Succeed())
+	})
+
+	AfterEach(func() {
+		ws.Close()
+		server.Close()
+		host.Close()
+	})
+
+	read := func() *message {
+		var msg message
+		Expect(ws.ReadJSON(&msg)).To(Succeed())
+		return &msg
+	}
+
+	It("Should stream the screen and its changes", func() {
+		msg := read()
+		Expect(msg.Type).To(Equal("screen"))
+		Expect(msg.Rows).To(Equal(24))
+		Expect(msg.Cols).To(Equal(80))
+		Expect(*msg.Cursor).To(Equal(position{0, 11}))
+		Expect(msg.Changes).To(HaveLen(24))
+		Expect(msg.Changes[0].Text).To(HavePrefix(" USER"))
+		Expect(msg.Changes[0].Text).NotTo(ContainSubstring("SECRET"))
+		Expect(msg.Fields).To(HaveLen(5))
+		Expect(msg.Fields[3].Hidden).To(BeTrue())
+
+		Expect(ws.WriteJSON(map[string]string{"type": "text", "text": "JDOE"})).To(Succeed())
+		msg = read()
+		Expect(msg.Changes).To(HaveLen(1))
+		Expect(msg.Changes[0].Col).To(Equal(11))
+		Expect(msg.Changes[0].Text).To(Equal("JDOE"))
+		Expect(msg.Fields).To(BeEmpty())
+		Expect(*msg.Cursor).To(Equal(position{0, 15}))
+
+		Expect(ws.WriteJSON(map[string]string{"type": "key", "key": "Enter"})).To(Succeed())
+		msg = read()
+		Expect(msg.KeyboardLocked).To(BeFalse())
+		Expect(msg.Fields).NotTo(BeNil())
+		Expect(msg.Fields).To(BeEmpty())
+		Expect(host.Err()).To(Succeed())
+	})
+
+	It("Should report invalid input", func() {
+		read()
+		Expect(ws.WriteJSON(map[string]interface{}{"type": "move", "row": 0, "col": 2})).To(Succeed())
+		read()
+		Expect(ws.WriteJSON(map[string]string{"type": "text", "text": "X"})).To(Succeed())
+		Expect(read().Error).To(Equal(tn3270.ErrProtected.Error()))
+		Expect(ws.WriteJSON(map[string]string{"type": "key", "key": "F1"})).To(Succeed())
+		Expect(read().Error).To(Equal("unknown key F1"))
+		Expect(ws.WriteMessage(websocket.TextMessage, []byte("{]"))).To(Succeed())
+		Expect(read().Error).NotTo(BeEmpty())
+		Expect(ws.WriteMessage(websocket.TextMessage, []byte(`{"type": 1}`))).To(Succeed())
+		Expect(read().Error).NotTo(BeEmpty())
+		Expect(ws.WriteJSON(map[string]string{"type": "tab"})).To(Succeed())
+		Expect(read().Error).To(BeEmpty())
+	})
+
+	It("Should report disconnections", func() {
+		read()
+		host.Close()
+		Expect(read().Type).To(Equal("disconnected"))
+	})
+})